@@ -3,14 +3,25 @@ package main
 import (
 	"context"
 	"fmt"
-	"time"
+	"os"
 
+	"pokedex_backend_go/domain/calc"
+	"pokedex_backend_go/domain/catalog"
+	"pokedex_backend_go/domain/collection"
 	"pokedex_backend_go/domain/login"
+	"pokedex_backend_go/domain/matchup"
+	"pokedex_backend_go/domain/pokemon"
 	"pokedex_backend_go/domain/profile"
 	"pokedex_backend_go/domain/register"
+	"pokedex_backend_go/domain/session"
+	"pokedex_backend_go/domain/team"
+	"pokedex_backend_go/pkg/auth"
+	"pokedex_backend_go/pkg/config"
 	"pokedex_backend_go/pkg/database"
 	fxhelper "pokedex_backend_go/pkg/helper"
+	pkglogger "pokedex_backend_go/pkg/logger"
 	"pokedex_backend_go/pkg/server"
+	"pokedex_backend_go/pkg/telemetry"
 
 	"go.uber.org/fx"
 	"go.uber.org/zap"
@@ -19,22 +30,54 @@ import (
 var logger *zap.Logger
 
 func main() {
+	// The configuration is loaded before fx so that a broken configuration is
+	// reported at once, and because the fx timeouts depend on it.
+	cfg, err := config.Load(config.FilesFromEnv()...)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if err := pkglogger.SetLevel(cfg.Log.Level); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	// Outside a request logger.FromContext falls back to zap.L(), which
+	// discards everything until it is replaced. Within one, the server
+	// middleware derives the request logger from it, see logger.NewContext.
+	zap.ReplaceGlobals(pkglogger.NewLogger(""))
 	logger = zap.L().WithOptions(zap.WithCaller(false)).Named("main")
 
 	app := fx.New(
 		// fx config
 		fx.WithLogger(fxhelper.Logger),
-		fx.StartTimeout(fxhelper.Timeout()),
-		fx.StopTimeout(fxhelper.Timeout()),
+		fx.StartTimeout(fxhelper.Timeout(cfg)),
+		fx.StopTimeout(fxhelper.Timeout(cfg)),
+
+		fx.Supply(cfg),
+
+		// Installs the global MeterProvider and TracerProvider that the
+		// database instrumentation uses
+		telemetry.TelemetryProvider(),
 
 		// Provide the database connection
 		fx.Provide(database.Connection),
 		fx.Provide(database.Gorm),
 		fx.Invoke(database.Invoke),
 
+		auth.AuthProvider(),
+
+		session.SessionProvider(),
 		login.LoginProvider(),
 		register.RegisterProvider(),
 		profile.ProfileProvider(),
+		pokemon.PokemonProvider(),
+		collection.CollectionProvider(),
+		team.TeamProvider(),
+		matchup.MatchupProvider(),
+		calc.CalcProvider(),
+		catalog.CatalogProvider(),
 
 		fx.Provide(server.New),
 		fx.Invoke(run),
@@ -61,6 +104,7 @@ type runParams struct {
 	fx.In
 	Lifecycle fx.Lifecycle
 
+	Config *config.Config
 	Server server.Service
 }
 
@@ -70,7 +114,7 @@ func run(params runParams) {
 			return params.Server.Start(ctx)
 		},
 		OnStop: func(ctx context.Context) error {
-			ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+			ctx, cancel := context.WithTimeout(ctx, params.Config.Server.ShutdownTimeout)
 			defer cancel()
 
 			return params.Server.Stop(ctx)
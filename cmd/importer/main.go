@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"pokedex_backend_go/pkg/config"
+	"pokedex_backend_go/pkg/database"
+	"pokedex_backend_go/pkg/importer"
+	pkglogger "pokedex_backend_go/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+func main() {
+	dir := flag.String("dir", "data/csv", "directory with the PokeAPI CSV dump (data/v2/csv in the PokeAPI repository)")
+	dryRun := flag.Bool("dry-run", false, "report what would change without writing to the database")
+	flag.Parse()
+
+	cfg, err := config.Load(config.FilesFromEnv()...)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if err := pkglogger.SetLevel(cfg.Log.Level); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	// Outside a request logger.FromContext falls back to zap.L(), so the
+	// repositories log as the importer, without the caller, since it is run
+	// by hand.
+	logger := pkglogger.NewLogger("importer", zap.WithCaller(false))
+	zap.ReplaceGlobals(logger)
+
+	if info, err := os.Stat(*dir); err != nil || !info.IsDir() {
+		logger.Fatal("CSV directory not found", zap.String("dir", *dir))
+	}
+
+	conn, err := database.Connection(cfg)
+	if err != nil {
+		logger.Fatal("Failed to connect to database", zap.Error(err))
+	}
+	defer conn.Close()
+
+	if _, err := database.Gorm(conn); err != nil {
+		logger.Fatal("Failed to open gorm connection", zap.Error(err))
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	summary, err := importer.Run(ctx, importer.Options{Dir: *dir, DryRun: *dryRun})
+	if err != nil {
+		logger.Fatal("Import failed", zap.Error(err))
+	}
+
+	summary.Print(os.Stdout)
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+
+	"pokedex_backend_go/pkg/config"
+	"pokedex_backend_go/pkg/database"
+	pkglogger "pokedex_backend_go/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+const usage = `Usage: migrate [flags] <command>
+
+Commands:
+  up               apply every pending migration
+  up-to VERSION    apply the pending migrations up to VERSION
+  down             roll back the last migration
+  redo             roll back the last migration and apply it again
+  status           show which migrations are applied
+  create NAME      write an empty migration to -dir
+
+Flags:
+`
+
+func main() {
+	dir := flag.String("dir", "migrations", "directory where create writes the new migration")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	command, args := flag.Arg(0), flag.Args()
+	if len(args) > 0 {
+		args = args[1:]
+	}
+
+	// create only writes a file, so it works without a database.
+	if command == "create" {
+		if len(args) != 1 {
+			flag.Usage()
+			os.Exit(2)
+		}
+
+		path, err := database.CreateMigration(*dir, args[0])
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		fmt.Println("Created", path)
+		return
+	}
+
+	var run func(ctx context.Context, conn *sql.DB) error
+	switch {
+	case command == "up" && len(args) == 0:
+		run = database.MigrationUp
+	case command == "up-to" && len(args) == 1:
+		version, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "invalid version %q\n", args[0])
+			os.Exit(2)
+		}
+		run = func(ctx context.Context, conn *sql.DB) error {
+			return database.MigrationUpTo(ctx, conn, version)
+		}
+	case command == "down" && len(args) == 0:
+		run = database.MigrationDown
+	case command == "redo" && len(args) == 0:
+		run = database.MigrationRedo
+	case command == "status" && len(args) == 0:
+		run = database.MigrationStatus
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	cfg, err := config.Load(config.FilesFromEnv()...)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if err := pkglogger.SetLevel(cfg.Log.Level); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	// Like the importer, migrate is run by hand, so the logs go to the
+	// console.
+	logger := pkglogger.NewLogger("migrate", zap.WithCaller(false))
+	zap.ReplaceGlobals(logger)
+
+	conn, err := database.Connection(cfg)
+	if err != nil {
+		logger.Fatal("Failed to connect to database", zap.Error(err))
+	}
+	defer conn.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if err := run(ctx, conn); err != nil {
+		logger.Fatal("Migration failed", zap.String("command", command), zap.Error(err))
+	}
+}
@@ -0,0 +1,21 @@
+package calc
+
+import (
+	"pokedex_backend_go/domain/calc/handler"
+	"pokedex_backend_go/domain/calc/repository"
+	"pokedex_backend_go/domain/calc/service"
+	"pokedex_backend_go/pkg/server"
+
+	"go.uber.org/fx"
+)
+
+func CalcProvider() fx.Option {
+	return fx.Options(
+		fx.Provide(
+			repository.NewRepository,
+			service.NewService,
+			server.AsHandler(handler.Handler),
+			handler.NewHandler,
+		),
+	)
+}
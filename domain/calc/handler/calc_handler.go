@@ -0,0 +1,152 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"pokedex_backend_go/domain/calc/service"
+	"pokedex_backend_go/pkg/logger"
+	"pokedex_backend_go/pkg/model"
+	"pokedex_backend_go/pkg/problem"
+	"pokedex_backend_go/pkg/request"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+type CalcHandler struct {
+	service *service.Service
+}
+
+func NewHandler(service *service.Service) *CalcHandler {
+	return &CalcHandler{
+		service: service,
+	}
+}
+
+func Handler(service *service.Service) func(chi.Router) {
+	return func(r chi.Router) {
+		logger := zap.L().Named("calc_handler_registration")
+		logger.Info("Registering calc handler at /api/v1/calc")
+
+		handler := NewHandler(service)
+
+		r.Post("/api/v1/calc/stats", handler.StatsRequest)
+		r.Post("/api/v1/calc/damage", handler.DamageRequest)
+	}
+}
+
+type StatsPayload struct {
+	Pokemon        string            `json:"pokemon"`
+	Level          int               `json:"level"`
+	Nature         string            `json:"nature"`
+	EVs            *model.StatSpread `json:"evs"`
+	IVs            *model.StatSpread `json:"ivs"`
+	Observed       *model.StatSpread `json:"observed"`
+	Characteristic string            `json:"characteristic"`
+}
+
+func (handler *CalcHandler) StatsRequest(w http.ResponseWriter, r *http.Request) {
+	var req StatsPayload
+	if err := request.Decode(w, r, &req); err != nil {
+		problem.Write(w, r, err)
+		return
+	}
+
+	ctx := r.Context()
+	response, err := handler.service.Stats(ctx, service.StatsInput{
+		Pokemon:        req.Pokemon,
+		Level:          req.Level,
+		Nature:         req.Nature,
+		EVs:            req.EVs,
+		IVs:            req.IVs,
+		Observed:       req.Observed,
+		Characteristic: req.Characteristic,
+	})
+	if err != nil {
+		handler.writeError(w, r, err, "Failed to calculate stats")
+		return
+	}
+
+	handler.writeJSON(w, r, http.StatusOK, response)
+}
+
+type BattlerPayload struct {
+	Pokemon   string            `json:"pokemon"`
+	Level     int               `json:"level"`
+	Nature    string            `json:"nature"`
+	EVs       *model.StatSpread `json:"evs"`
+	IVs       *model.StatSpread `json:"ivs"`
+	Boosts    *model.StatSpread `json:"boosts"`
+	Ability   string            `json:"ability"`
+	Item      string            `json:"item"`
+	Status    string            `json:"status"`
+	CurrentHP int               `json:"current_hp"`
+}
+
+func (p BattlerPayload) input() service.BattlerInput {
+	return service.BattlerInput{
+		Pokemon:   p.Pokemon,
+		Level:     p.Level,
+		Nature:    p.Nature,
+		EVs:       p.EVs,
+		IVs:       p.IVs,
+		Boosts:    p.Boosts,
+		Ability:   p.Ability,
+		Item:      p.Item,
+		Status:    p.Status,
+		CurrentHP: p.CurrentHP,
+	}
+}
+
+type DamagePayload struct {
+	Attacker BattlerPayload `json:"attacker"`
+	Defender BattlerPayload `json:"defender"`
+	Move     string         `json:"move"`
+	Power    int            `json:"power"`
+	Weather  string         `json:"weather"`
+	Terrain  string         `json:"terrain"`
+	Spread   bool           `json:"spread"`
+	Critical bool           `json:"critical"`
+}
+
+func (handler *CalcHandler) DamageRequest(w http.ResponseWriter, r *http.Request) {
+	var req DamagePayload
+	if err := request.Decode(w, r, &req); err != nil {
+		problem.Write(w, r, err)
+		return
+	}
+
+	ctx := r.Context()
+	response, err := handler.service.Damage(ctx, service.DamageInput{
+		Attacker: req.Attacker.input(),
+		Defender: req.Defender.input(),
+		Move:     req.Move,
+		Power:    req.Power,
+		Weather:  req.Weather,
+		Terrain:  req.Terrain,
+		Spread:   req.Spread,
+		Critical: req.Critical,
+	})
+	if err != nil {
+		handler.writeError(w, r, err, "Failed to calculate damage")
+		return
+	}
+
+	handler.writeJSON(w, r, http.StatusOK, response)
+}
+
+func (handler *CalcHandler) writeError(w http.ResponseWriter, r *http.Request, err error, message string) {
+	if problem.Internal(err) {
+		logger.FromContext(r.Context()).Error(message, zap.Error(err))
+	}
+	problem.Write(w, r, err)
+}
+
+func (handler *CalcHandler) writeJSON(w http.ResponseWriter, r *http.Request, status int, response any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.FromContext(r.Context()).Error("Failed to encode calc response", zap.Error(err))
+	}
+}
@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"pokedex_backend_go/pkg/database"
+	"pokedex_backend_go/pkg/logger"
+	"pokedex_backend_go/pkg/model"
+	"pokedex_backend_go/pkg/problem"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrPokemonNotFound        = errors.New("pokemon not found")
+	ErrNatureNotFound         = errors.New("nature not found")
+	ErrCharacteristicNotFound = errors.New("characteristic not found")
+	ErrMoveNotFound           = errors.New("move not found")
+
+	// ErrBaseStatsNotLoaded reports that the base stats were never imported,
+	// which would otherwise make every pokemon look unknown. The migrations
+	// only seed natures and characteristics; cmd/importer loads the rest.
+	ErrBaseStatsNotLoaded = problem.New(http.StatusServiceUnavailable, "base_stats_not_loaded", "Base stats are not loaded")
+)
+
+// Move is a move with the name of its type.
+type Move struct {
+	Name        string
+	Type        string
+	Power       *int
+	DamageClass string
+}
+
+func NewRepository() *Repository {
+	return &Repository{}
+}
+
+type Repository struct {
+}
+
+// GetPokemonByName returns a variety with its base stats and types. Like
+// pokemon.Repository.GetVariety, species names resolve to their default
+// variety, e.g. mimikyu to mimikyu-disguised.
+func (r *Repository) GetPokemonByName(ctx context.Context, name string) (*model.Pokemon, error) {
+	orm := database.Orm(ctx)
+
+	withStats := func(db *gorm.DB) *gorm.DB {
+		return db.
+			Preload("Stats").
+			Preload("Types", func(db *gorm.DB) *gorm.DB { return db.Order("slot") }).
+			Preload("Types.Type")
+	}
+
+	var pokemon model.Pokemon
+	result := withStats(orm.WithContext(ctx)).Where("name = ?", name).First(&pokemon)
+	if result.Error == nil {
+		return &pokemon, nil
+	}
+	if !errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		logger.FromContext(ctx).Error("Failed to get pokemon", zap.String("name", name), zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	result = withStats(orm.WithContext(ctx)).
+		Where("is_default AND species_id = (SELECT id FROM pokemon_species WHERE name = ?)", name).
+		First(&pokemon)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, r.notFound(ctx)
+		}
+		logger.FromContext(ctx).Error("Failed to get default variety", zap.String("name", name), zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	return &pokemon, nil
+}
+
+// notFound tells an unknown pokemon apart from a catalog that was never
+// imported.
+func (r *Repository) notFound(ctx context.Context) error {
+	orm := database.Orm(ctx)
+
+	var loaded bool
+	result := orm.WithContext(ctx).Raw("SELECT EXISTS (SELECT 1 FROM pokemon_base_stats)").Scan(&loaded)
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to check base stats", zap.Error(result.Error))
+		return result.Error
+	}
+	if !loaded {
+		logger.FromContext(ctx).Error("Base stats are not loaded, run cmd/importer")
+		return ErrBaseStatsNotLoaded
+	}
+
+	return ErrPokemonNotFound
+}
+
+func (r *Repository) GetNatureByName(ctx context.Context, name string) (*model.Nature, error) {
+	orm := database.Orm(ctx)
+
+	var nature model.Nature
+	result := orm.WithContext(ctx).Where("name = ?", name).First(&nature)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrNatureNotFound
+		}
+		logger.FromContext(ctx).Error("Failed to get nature", zap.String("name", name), zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	return &nature, nil
+}
+
+// GetCharacteristic looks a characteristic up by its description, ignoring
+// case.
+func (r *Repository) GetCharacteristic(ctx context.Context, description string) (*model.Characteristic, error) {
+	orm := database.Orm(ctx)
+
+	var characteristic model.Characteristic
+	result := orm.WithContext(ctx).Where("LOWER(description) = LOWER(?)", description).First(&characteristic)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrCharacteristicNotFound
+		}
+		logger.FromContext(ctx).Error("Failed to get characteristic", zap.String("description", description), zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	return &characteristic, nil
+}
+
+func (r *Repository) GetMoveByName(ctx context.Context, name string) (*Move, error) {
+	orm := database.Orm(ctx)
+
+	var moves []Move
+	result := orm.WithContext(ctx).
+		Table("moves").
+		Select("moves.name, types.name AS type, moves.power, moves.damage_class").
+		Joins("JOIN types ON types.id = moves.type_id").
+		Where("moves.name = ?", name).
+		Limit(1).
+		Scan(&moves)
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to get move", zap.String("name", name), zap.Error(result.Error))
+		return nil, result.Error
+	}
+	if len(moves) == 0 {
+		return nil, ErrMoveNotFound
+	}
+
+	return &moves[0], nil
+}
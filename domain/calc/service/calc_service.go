@@ -0,0 +1,393 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"pokedex_backend_go/domain/calc/repository"
+	"pokedex_backend_go/pkg/calc"
+	"pokedex_backend_go/pkg/dto"
+	"pokedex_backend_go/pkg/identifier"
+	"pokedex_backend_go/pkg/model"
+	"pokedex_backend_go/pkg/typechart"
+	"pokedex_backend_go/pkg/validation"
+)
+
+// StatsInput asks for the stats of a pokemon. When Observed is set the
+// request is reversed: IVs is ignored and the IVs explaining the observed
+// stats are inferred instead. A zero Level means 100, missing EVs mean none,
+// missing IVs mean 31 and an empty Nature is neutral.
+type StatsInput struct {
+	Pokemon        string
+	Level          int
+	Nature         string
+	EVs            *model.StatSpread
+	IVs            *model.StatSpread
+	Observed       *model.StatSpread
+	Characteristic string
+}
+
+// BattlerInput describes one side of a damage calculation. Its stats are
+// computed like in StatsInput; Boosts holds the stat stages and Status the
+// major status condition, if any. A zero CurrentHP means full HP.
+type BattlerInput struct {
+	Pokemon   string
+	Level     int
+	Nature    string
+	EVs       *model.StatSpread
+	IVs       *model.StatSpread
+	Boosts    *model.StatSpread
+	Ability   string
+	Item      string
+	Status    string
+	CurrentHP int
+}
+
+// DamageInput asks for the damage of a move. Power overrides the move's base
+// power, which is required for moves whose power varies.
+type DamageInput struct {
+	Attacker BattlerInput
+	Defender BattlerInput
+	Move     string
+	Power    int
+	Weather  string
+	Terrain  string
+	Spread   bool
+	Critical bool
+}
+
+const StatusBurn = "burn"
+
+var (
+	statuses = map[string]bool{"": true, StatusBurn: true, "paralysis": true, "poison": true, "toxic": true, "sleep": true, "freeze": true}
+	weathers = map[string]bool{"": true, calc.WeatherSun: true, calc.WeatherRain: true, calc.WeatherSand: true, calc.WeatherSnow: true}
+	terrains = map[string]bool{"": true, calc.TerrainElectric: true, calc.TerrainGrassy: true, calc.TerrainPsychic: true, calc.TerrainMisty: true}
+)
+
+func NewService(repo *repository.Repository) *Service {
+	return &Service{
+		repo: repo,
+	}
+}
+
+type Service struct {
+	repo *repository.Repository
+}
+
+func (s *Service) Stats(ctx context.Context, input StatsInput) (*dto.StatsResponse, error) {
+	var errs validation.Errors
+
+	in := calc.StatInput{
+		Level: input.Level,
+		IVs:   model.StatSpread{HP: calc.MaxIV, Attack: calc.MaxIV, Defense: calc.MaxIV, SpecialAttack: calc.MaxIV, SpecialDefense: calc.MaxIV, Speed: calc.MaxIV},
+	}
+	if in.Level == 0 {
+		in.Level = calc.MaxLevel
+	}
+	if input.EVs != nil {
+		in.EVs = *input.EVs
+	}
+	if input.IVs != nil {
+		in.IVs = *input.IVs
+	}
+
+	response := &dto.StatsResponse{
+		Pokemon: identifier.Normalize(input.Pokemon),
+		Level:   in.Level,
+		EVs:     in.EVs,
+	}
+
+	pokemon, err := s.pokemon(ctx, "pokemon", response.Pokemon, &errs)
+	if err != nil {
+		return nil, err
+	}
+	if pokemon != nil {
+		in.Base = pokemon.Stats.Spread()
+		response.Base = in.Base
+	}
+
+	in.Nature, err = s.nature(ctx, "nature", input.Nature, &errs)
+	if err != nil {
+		return nil, err
+	}
+	if in.Nature != nil {
+		response.Nature = in.Nature.Name
+	}
+
+	var characteristic *model.Characteristic
+	if description := strings.TrimSpace(input.Characteristic); description != "" {
+		characteristic, err = s.repo.GetCharacteristic(ctx, description)
+		switch {
+		case errors.Is(err, repository.ErrCharacteristicNotFound):
+			errs.Add("characteristic", "unknown_characteristic", "unknown characteristic %q", input.Characteristic)
+		case err != nil:
+			return nil, err
+		}
+	}
+
+	if in.Level < 1 || in.Level > calc.MaxLevel {
+		errs.Add("level", "out_of_range", "level must be between 1 and %d", calc.MaxLevel)
+	}
+	checkSpread(&errs, "evs", in.EVs, 0, calc.MaxEV, "ev_out_of_range")
+	if total := in.EVs.Total(); total > calc.MaxEVTotal {
+		errs.Add("evs", "ev_total_exceeded", "EVs add up to %d, the maximum is %d", total, calc.MaxEVTotal)
+	}
+
+	if input.Observed == nil {
+		if input.Characteristic != "" {
+			errs.Add("characteristic", "requires_observed", "a characteristic only applies when inferring IVs from observed stats")
+		}
+		checkSpread(&errs, "ivs", in.IVs, 0, calc.MaxIV, "iv_out_of_range")
+		if err := errs.Err(); err != nil {
+			return nil, err
+		}
+
+		stats := calc.Stats(in)
+		response.IVs = &in.IVs
+		response.Stats = &stats
+		return response, nil
+	}
+
+	checkSpread(&errs, "observed", *input.Observed, 1, 0, "out_of_range")
+	if err := errs.Err(); err != nil {
+		return nil, err
+	}
+
+	candidates := calc.InferIVs(in, *input.Observed, characteristic)
+	consistent := true
+	response.Observed = input.Observed
+	response.IVRanges = make(map[string]dto.IVRange, len(candidates))
+	for name, values := range candidates {
+		r := dto.IVRange{Values: values}
+		if len(values) > 0 {
+			r.Min, r.Max = &values[0], &values[len(values)-1]
+		} else {
+			consistent = false
+		}
+		response.IVRanges[name] = r
+	}
+	response.Consistent = &consistent
+
+	return response, nil
+}
+
+func (s *Service) Damage(ctx context.Context, input DamageInput) (*dto.DamageResponse, error) {
+	var errs validation.Errors
+
+	attacker, err := s.battler(ctx, "attacker", input.Attacker, &errs)
+	if err != nil {
+		return nil, err
+	}
+	defender, err := s.battler(ctx, "defender", input.Defender, &errs)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.DamageResponse{
+		Attacker: identifier.Normalize(input.Attacker.Pokemon),
+		Defender: identifier.Normalize(input.Defender.Pokemon),
+		Move:     identifier.Normalize(input.Move),
+		Weather:  identifier.Normalize(input.Weather),
+		Terrain:  identifier.Normalize(input.Terrain),
+		Critical: input.Critical,
+		Spread:   input.Spread,
+	}
+
+	var move *repository.Move
+	if response.Move == "" {
+		errs.Add("move", "required", "move is required")
+	} else {
+		move, err = s.repo.GetMoveByName(ctx, response.Move)
+		switch {
+		case errors.Is(err, repository.ErrMoveNotFound):
+			errs.Add("move", "unknown_move", "unknown move %q", input.Move)
+		case err != nil:
+			return nil, err
+		case move.DamageClass == model.DamageClassStatus:
+			errs.Add("move", "status_move", "%s is a status move and deals no damage", move.Name)
+		case input.Power == 0 && move.Power == nil:
+			errs.Add("power", "required", "%s has variable power, pass it in power", move.Name)
+		}
+	}
+	if input.Power < 0 {
+		errs.Add("power", "out_of_range", "power must be positive, got %d", input.Power)
+	}
+
+	if !weathers[response.Weather] {
+		errs.Add("weather", "unknown_weather", "unknown weather %q", input.Weather)
+	}
+	if !terrains[response.Terrain] {
+		errs.Add("terrain", "unknown_terrain", "unknown terrain %q", input.Terrain)
+	}
+
+	if err := errs.Err(); err != nil {
+		return nil, err
+	}
+
+	response.Type = move.Type
+	response.DamageClass = move.DamageClass
+	response.Power = input.Power
+	if response.Power == 0 {
+		response.Power = *move.Power
+	}
+	response.DefenderHP = defender.Stats.HP
+	if defender.CurrentHP > 0 {
+		response.DefenderHP = defender.CurrentHP
+	}
+
+	result, err := calc.Damage(calc.DamageInput{
+		Attacker: *attacker,
+		Defender: *defender,
+		Move:     calc.Move{Type: move.Type, Power: response.Power, DamageClass: move.DamageClass},
+		Field:    calc.Field{Weather: response.Weather, Terrain: response.Terrain, Spread: input.Spread, Critical: input.Critical},
+		Chart:    typechart.Latest(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response.Effectiveness = result.Effectiveness
+	response.Rolls = result.Rolls
+	response.Min, response.Max = result.Min, result.Max
+	response.MinPercent, response.MaxPercent = result.MinPercent, result.MaxPercent
+	response.KO = dto.KOChance{Hits: result.KO.Hits, Chance: result.KO.Chance}
+
+	return response, nil
+}
+
+// battler computes the stats of one side of a damage calculation. Problems
+// are added to errs under field, and a nil battler is returned with them.
+func (s *Service) battler(ctx context.Context, field string, input BattlerInput, errs *validation.Errors) (*calc.Battler, error) {
+	valid := len(*errs)
+
+	in := calc.StatInput{
+		Level: input.Level,
+		IVs:   model.StatSpread{HP: calc.MaxIV, Attack: calc.MaxIV, Defense: calc.MaxIV, SpecialAttack: calc.MaxIV, SpecialDefense: calc.MaxIV, Speed: calc.MaxIV},
+	}
+	if in.Level == 0 {
+		in.Level = calc.MaxLevel
+	}
+	if input.EVs != nil {
+		in.EVs = *input.EVs
+	}
+	if input.IVs != nil {
+		in.IVs = *input.IVs
+	}
+
+	battler := &calc.Battler{
+		Level:     in.Level,
+		Ability:   identifier.Normalize(input.Ability),
+		Item:      identifier.Normalize(input.Item),
+		CurrentHP: input.CurrentHP,
+	}
+	if input.Boosts != nil {
+		battler.Boosts = *input.Boosts
+	}
+
+	pokemon, err := s.pokemon(ctx, field+".pokemon", identifier.Normalize(input.Pokemon), errs)
+	if err != nil {
+		return nil, err
+	}
+	if pokemon != nil {
+		in.Base = pokemon.Stats.Spread()
+		for _, t := range pokemon.Types {
+			battler.Types = append(battler.Types, t.Type.Name)
+		}
+	}
+
+	in.Nature, err = s.nature(ctx, field+".nature", input.Nature, errs)
+	if err != nil {
+		return nil, err
+	}
+
+	status := identifier.Normalize(input.Status)
+	if !statuses[status] {
+		errs.Add(field+".status", "unknown_status", "unknown status %q", input.Status)
+	}
+	battler.Statused = status != ""
+	battler.Burned = status == StatusBurn
+
+	if in.Level < 1 || in.Level > calc.MaxLevel {
+		errs.Add(field+".level", "out_of_range", "level must be between 1 and %d", calc.MaxLevel)
+	}
+	checkSpread(errs, field+".evs", in.EVs, 0, calc.MaxEV, "ev_out_of_range")
+	if total := in.EVs.Total(); total > calc.MaxEVTotal {
+		errs.Add(field+".evs", "ev_total_exceeded", "EVs add up to %d, the maximum is %d", total, calc.MaxEVTotal)
+	}
+	checkSpread(errs, field+".ivs", in.IVs, 0, calc.MaxIV, "iv_out_of_range")
+	checkSpread(errs, field+".boosts", battler.Boosts, calc.MinStage, calc.MaxStage, "stage_out_of_range")
+	if input.CurrentHP < 0 {
+		errs.Add(field+".current_hp", "out_of_range", "current HP must be positive, got %d", input.CurrentHP)
+	}
+
+	if len(*errs) > valid {
+		return nil, nil
+	}
+
+	battler.Stats = calc.Stats(in)
+	if battler.CurrentHP > battler.Stats.HP {
+		errs.Add(field+".current_hp", "out_of_range", "current HP is above the maximum of %d", battler.Stats.HP)
+		return nil, nil
+	}
+
+	return battler, nil
+}
+
+// pokemon looks the pokemon up, adding a field error rather than failing
+// when it is unknown so every problem of the request is reported together.
+func (s *Service) pokemon(ctx context.Context, field, name string, errs *validation.Errors) (*model.Pokemon, error) {
+	if name == "" {
+		errs.Add(field, "required", "pokemon is required")
+		return nil, nil
+	}
+
+	pokemon, err := s.repo.GetPokemonByName(ctx, name)
+	switch {
+	case errors.Is(err, repository.ErrPokemonNotFound):
+		errs.Add(field, "unknown_pokemon", "unknown pokemon %q", name)
+		return nil, nil
+	case err != nil:
+		return nil, err
+	case pokemon.Stats == nil:
+		errs.Add(field, "missing_base_stats", "%s has no base stats", name)
+		return nil, nil
+	}
+
+	return pokemon, nil
+}
+
+// nature looks the nature up, returning nil for an empty name, which is
+// neutral.
+func (s *Service) nature(ctx context.Context, field, name string, errs *validation.Errors) (*model.Nature, error) {
+	normalized := identifier.Normalize(name)
+	if normalized == "" {
+		return nil, nil
+	}
+
+	nature, err := s.repo.GetNatureByName(ctx, normalized)
+	switch {
+	case errors.Is(err, repository.ErrNatureNotFound):
+		errs.Add(field, "unknown_nature", "unknown nature %q", name)
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+
+	return nature, nil
+}
+
+// checkSpread checks every stat is between min and max; a zero max means no
+// upper bound.
+func checkSpread(errs *validation.Errors, field string, spread model.StatSpread, min, max int, code string) {
+	for _, name := range model.StatNames {
+		value := *spread.Field(name)
+		if value < min || (max > 0 && value > max) {
+			if max > 0 {
+				errs.Add(field+"."+name, code, "must be between %d and %d, got %d", min, max, value)
+			} else {
+				errs.Add(field+"."+name, code, "must be at least %d, got %d", min, value)
+			}
+		}
+	}
+}
@@ -0,0 +1,21 @@
+package catalog
+
+import (
+	"pokedex_backend_go/domain/catalog/handler"
+	"pokedex_backend_go/domain/catalog/repository"
+	"pokedex_backend_go/domain/catalog/service"
+	"pokedex_backend_go/pkg/server"
+
+	"go.uber.org/fx"
+)
+
+func CatalogProvider() fx.Option {
+	return fx.Options(
+		fx.Provide(
+			repository.NewRepository,
+			service.NewService,
+			server.AsHandler(handler.Handler),
+			handler.NewHandler,
+		),
+	)
+}
@@ -0,0 +1,180 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"pokedex_backend_go/domain/catalog/repository"
+	"pokedex_backend_go/domain/catalog/service"
+	"pokedex_backend_go/pkg/i18n"
+	"pokedex_backend_go/pkg/logger"
+	"pokedex_backend_go/pkg/problem"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+type CatalogHandler struct {
+	service *service.Service
+}
+
+func NewHandler(service *service.Service) *CatalogHandler {
+	return &CatalogHandler{
+		service: service,
+	}
+}
+
+func Handler(service *service.Service) func(chi.Router) {
+	return func(r chi.Router) {
+		logger := zap.L().Named("catalog_handler_registration")
+		logger.Info("Registering catalog handler at /api/v1/moves, /api/v1/abilities and /api/v1/items")
+
+		handler := NewHandler(service)
+
+		r.Get("/api/v1/moves", handler.ListMoves)
+		r.Get("/api/v1/moves/{idOrName}", handler.GetMove)
+		r.Get("/api/v1/abilities", handler.ListAbilities)
+		r.Get("/api/v1/abilities/{idOrName}", handler.GetAbility)
+		r.Get("/api/v1/items", handler.ListItems)
+		r.Get("/api/v1/items/{idOrName}", handler.GetItem)
+	}
+}
+
+func (handler *CatalogHandler) ListMoves(w http.ResponseWriter, r *http.Request) {
+	page, pageSize, ok := pagination(w, r)
+	if !ok {
+		return
+	}
+
+	filter := repository.MoveFilter{
+		Type:        r.URL.Query().Get("type"),
+		DamageClass: r.URL.Query().Get("damage_class"),
+	}
+
+	ctx := r.Context()
+	response, err := handler.service.ListMoves(ctx, filter, page, pageSize)
+	if err != nil {
+		handler.writeError(w, r, err, "Failed to list moves")
+		return
+	}
+
+	handler.writeJSON(w, r, http.StatusOK, response)
+}
+
+func (handler *CatalogHandler) GetMove(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	response, err := handler.service.GetMove(ctx, chi.URLParam(r, "idOrName"))
+	if err != nil {
+		handler.writeError(w, r, err, "Failed to get move")
+		return
+	}
+
+	handler.writeJSON(w, r, http.StatusOK, response)
+}
+
+func (handler *CatalogHandler) ListAbilities(w http.ResponseWriter, r *http.Request) {
+	page, pageSize, ok := pagination(w, r)
+	if !ok {
+		return
+	}
+
+	ctx := r.Context()
+	response, err := handler.service.ListAbilities(ctx, page, pageSize)
+	if err != nil {
+		handler.writeError(w, r, err, "Failed to list abilities")
+		return
+	}
+
+	handler.writeJSON(w, r, http.StatusOK, response)
+}
+
+func (handler *CatalogHandler) GetAbility(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	response, err := handler.service.GetAbility(ctx, chi.URLParam(r, "idOrName"))
+	if err != nil {
+		handler.writeError(w, r, err, "Failed to get ability")
+		return
+	}
+
+	handler.writeJSON(w, r, http.StatusOK, response)
+}
+
+func (handler *CatalogHandler) ListItems(w http.ResponseWriter, r *http.Request) {
+	page, pageSize, ok := pagination(w, r)
+	if !ok {
+		return
+	}
+
+	filter := repository.ItemFilter{Category: r.URL.Query().Get("category")}
+	if value := r.URL.Query().Get("holdable"); value != "" {
+		holdable, err := strconv.ParseBool(value)
+		if err != nil {
+			problem.Write(w, r, service.ErrInvalidHoldable)
+			return
+		}
+		filter.Holdable = &holdable
+	}
+
+	ctx := r.Context()
+	response, err := handler.service.ListItems(ctx, filter, page, pageSize)
+	if err != nil {
+		handler.writeError(w, r, err, "Failed to list items")
+		return
+	}
+
+	handler.writeJSON(w, r, http.StatusOK, response)
+}
+
+func (handler *CatalogHandler) GetItem(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	response, err := handler.service.GetItem(ctx, chi.URLParam(r, "idOrName"))
+	if err != nil {
+		handler.writeError(w, r, err, "Failed to get item")
+		return
+	}
+
+	handler.writeJSON(w, r, http.StatusOK, response)
+}
+
+// pagination reads page and page_size, writing a 400 when they are not
+// numbers.
+func pagination(w http.ResponseWriter, r *http.Request) (page, pageSize int, ok bool) {
+	page, err := queryInt(r, "page", 1)
+	if err != nil {
+		problem.Write(w, r, i18n.Errorf(service.ErrInvalidPagination, "page must be a number"))
+		return 0, 0, false
+	}
+
+	pageSize, err = queryInt(r, "page_size", service.DefaultPageSize)
+	if err != nil {
+		problem.Write(w, r, i18n.Errorf(service.ErrInvalidPagination, "page_size must be a number"))
+		return 0, 0, false
+	}
+
+	return page, pageSize, true
+}
+
+func queryInt(r *http.Request, name string, fallback int) (int, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return fallback, nil
+	}
+
+	return strconv.Atoi(value)
+}
+
+func (handler *CatalogHandler) writeError(w http.ResponseWriter, r *http.Request, err error, message string) {
+	if problem.Internal(err) {
+		logger.FromContext(r.Context()).Error(message, zap.Error(err))
+	}
+	problem.Write(w, r, err)
+}
+
+func (handler *CatalogHandler) writeJSON(w http.ResponseWriter, r *http.Request, status int, response any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.FromContext(r.Context()).Error("Failed to encode catalog response", zap.Error(err))
+	}
+}
@@ -0,0 +1,195 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"pokedex_backend_go/pkg/database"
+	"pokedex_backend_go/pkg/logger"
+	"pokedex_backend_go/pkg/model"
+	"pokedex_backend_go/pkg/problem"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrMoveNotFound    = problem.New(http.StatusNotFound, "move_not_found", "Move not found")
+	ErrAbilityNotFound = problem.New(http.StatusNotFound, "ability_not_found", "Ability not found")
+	ErrItemNotFound    = problem.New(http.StatusNotFound, "item_not_found", "Item not found")
+)
+
+// MoveFilter narrows ListMoves; empty fields match everything.
+type MoveFilter struct {
+	Type        string
+	DamageClass string
+}
+
+// ItemFilter narrows ListItems; nil and empty fields match everything.
+type ItemFilter struct {
+	Category string
+	Holdable *bool
+}
+
+func NewRepository() *Repository {
+	return &Repository{}
+}
+
+type Repository struct {
+}
+
+func (r *Repository) ListMoves(ctx context.Context, filter MoveFilter, offset, limit int) (moves []model.Move, total int64, err error) {
+	orm := database.Orm(ctx)
+
+	query := orm.WithContext(ctx).Model(&model.Move{})
+	if filter.Type != "" {
+		query = query.Where("type_id = (SELECT id FROM types WHERE name = ?)", filter.Type)
+	}
+	if filter.DamageClass != "" {
+		query = query.Where("damage_class = ?", filter.DamageClass)
+	}
+
+	// A new session lets the query be reused for both the count and the
+	// page.
+	query = query.Session(&gorm.Session{})
+	if err := query.Count(&total).Error; err != nil {
+		logger.FromContext(ctx).Error("Failed to count moves", zap.Error(err))
+		return nil, 0, err
+	}
+
+	result := query.Preload("Type").Order("id").Offset(offset).Limit(limit).Find(&moves)
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to list moves", zap.Int("offset", offset), zap.Int("limit", limit), zap.Error(result.Error))
+		return nil, 0, result.Error
+	}
+
+	return moves, total, nil
+}
+
+func (r *Repository) GetMove(ctx context.Context, query string, args ...interface{}) (*model.Move, error) {
+	orm := database.Orm(ctx)
+
+	var move model.Move
+	result := orm.WithContext(ctx).Preload("Type").Where(query, args...).First(&move)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrMoveNotFound
+		}
+		logger.FromContext(ctx).Error("Failed to get move", zap.Any("query", args), zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	return &move, nil
+}
+
+func (r *Repository) ListAbilities(ctx context.Context, offset, limit int) (abilities []model.Ability, total int64, err error) {
+	orm := database.Orm(ctx)
+
+	query := orm.WithContext(ctx).Model(&model.Ability{})
+	query = query.Session(&gorm.Session{})
+	if err := query.Count(&total).Error; err != nil {
+		logger.FromContext(ctx).Error("Failed to count abilities", zap.Error(err))
+		return nil, 0, err
+	}
+
+	result := query.Order("id").Offset(offset).Limit(limit).Find(&abilities)
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to list abilities", zap.Int("offset", offset), zap.Int("limit", limit), zap.Error(result.Error))
+		return nil, 0, result.Error
+	}
+
+	return abilities, total, nil
+}
+
+func (r *Repository) GetAbility(ctx context.Context, query string, args ...interface{}) (*model.Ability, error) {
+	orm := database.Orm(ctx)
+
+	var ability model.Ability
+	result := orm.WithContext(ctx).Where(query, args...).First(&ability)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrAbilityNotFound
+		}
+		logger.FromContext(ctx).Error("Failed to get ability", zap.Any("query", args), zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	return &ability, nil
+}
+
+func (r *Repository) ListItems(ctx context.Context, filter ItemFilter, offset, limit int) (items []model.Item, total int64, err error) {
+	orm := database.Orm(ctx)
+
+	query := orm.WithContext(ctx).Model(&model.Item{})
+	if filter.Category != "" {
+		query = query.Where("category = ?", filter.Category)
+	}
+	if filter.Holdable != nil {
+		query = query.Where("holdable = ?", *filter.Holdable)
+	}
+
+	query = query.Session(&gorm.Session{})
+	if err := query.Count(&total).Error; err != nil {
+		logger.FromContext(ctx).Error("Failed to count items", zap.Error(err))
+		return nil, 0, err
+	}
+
+	result := query.Order("id").Offset(offset).Limit(limit).Find(&items)
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to list items", zap.Int("offset", offset), zap.Int("limit", limit), zap.Error(result.Error))
+		return nil, 0, result.Error
+	}
+
+	return items, total, nil
+}
+
+func (r *Repository) GetItem(ctx context.Context, query string, args ...interface{}) (*model.Item, error) {
+	orm := database.Orm(ctx)
+
+	var item model.Item
+	result := orm.WithContext(ctx).Where(query, args...).First(&item)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrItemNotFound
+		}
+		logger.FromContext(ctx).Error("Failed to get item", zap.Any("query", args), zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	return &item, nil
+}
+
+// MoveTranslations returns the translations of the given moves in the given
+// languages.
+func (r *Repository) MoveTranslations(ctx context.Context, ids []int, languages []string) ([]model.MoveTranslation, error) {
+	orm := database.Orm(ctx)
+
+	var translations []model.MoveTranslation
+	result := orm.WithContext(ctx).
+		Where("move_id IN ? AND language IN ?", ids, languages).
+		Find(&translations)
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to get move translations", zap.Strings("languages", languages), zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	return translations, nil
+}
+
+// AbilityTranslations returns the translations of the given abilities in
+// the given languages.
+func (r *Repository) AbilityTranslations(ctx context.Context, ids []int, languages []string) ([]model.AbilityTranslation, error) {
+	orm := database.Orm(ctx)
+
+	var translations []model.AbilityTranslation
+	result := orm.WithContext(ctx).
+		Where("ability_id IN ? AND language IN ?", ids, languages).
+		Find(&translations)
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to get ability translations", zap.Strings("languages", languages), zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	return translations, nil
+}
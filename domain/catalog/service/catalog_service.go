@@ -0,0 +1,273 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"pokedex_backend_go/domain/catalog/repository"
+	"pokedex_backend_go/pkg/dto"
+	"pokedex_backend_go/pkg/i18n"
+	"pokedex_backend_go/pkg/model"
+	"pokedex_backend_go/pkg/problem"
+)
+
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+var (
+	ErrInvalidPagination  = problem.New(http.StatusBadRequest, "invalid_pagination", "page must be at least 1 and page_size between 1 and 100")
+	ErrInvalidDamageClass = problem.New(http.StatusBadRequest, "invalid_filter", "damage_class must be physical, special or status")
+	ErrInvalidHoldable    = problem.New(http.StatusBadRequest, "invalid_filter", "holdable must be true or false")
+)
+
+func NewService(repo *repository.Repository) *Service {
+	return &Service{
+		repo: repo,
+	}
+}
+
+type Service struct {
+	repo *repository.Repository
+}
+
+func (s *Service) ListMoves(ctx context.Context, filter repository.MoveFilter, page, pageSize int) (*dto.MoveListResponse, error) {
+	if err := checkPagination(page, pageSize); err != nil {
+		return nil, err
+	}
+
+	filter.Type = strings.ToLower(strings.TrimSpace(filter.Type))
+	filter.DamageClass = strings.ToLower(strings.TrimSpace(filter.DamageClass))
+	switch filter.DamageClass {
+	case "", model.DamageClassPhysical, model.DamageClassSpecial, model.DamageClassStatus:
+	default:
+		return nil, ErrInvalidDamageClass
+	}
+
+	moves, total, err := s.repo.ListMoves(ctx, filter, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, 0, len(moves))
+	for _, m := range moves {
+		ids = append(ids, m.ID)
+	}
+	translations, err := s.moveTranslations(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dto.MoveResponse, 0, len(moves))
+	for _, m := range moves {
+		items = append(items, moveResponse(m, translations[m.ID]))
+	}
+
+	return &dto.MoveListResponse{Items: items, Page: page, PageSize: pageSize, Total: total}, nil
+}
+
+// GetMove looks a move up by id or by name.
+func (s *Service) GetMove(ctx context.Context, idOrName string) (*dto.MoveResponse, error) {
+	query, arg, ok := byIDOrName(idOrName)
+	if !ok {
+		return nil, repository.ErrMoveNotFound
+	}
+
+	move, err := s.repo.GetMove(ctx, query, arg)
+	if err != nil {
+		return nil, err
+	}
+
+	translations, err := s.moveTranslations(ctx, []int{move.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	response := moveResponse(*move, translations[move.ID])
+	return &response, nil
+}
+
+func (s *Service) ListAbilities(ctx context.Context, page, pageSize int) (*dto.AbilityListResponse, error) {
+	if err := checkPagination(page, pageSize); err != nil {
+		return nil, err
+	}
+
+	abilities, total, err := s.repo.ListAbilities(ctx, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, 0, len(abilities))
+	for _, a := range abilities {
+		ids = append(ids, a.ID)
+	}
+	translations, err := s.abilityTranslations(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dto.AbilityResponse, 0, len(abilities))
+	for _, a := range abilities {
+		items = append(items, abilityResponse(a, translations[a.ID]))
+	}
+
+	return &dto.AbilityListResponse{Items: items, Page: page, PageSize: pageSize, Total: total}, nil
+}
+
+// GetAbility looks an ability up by id or by name.
+func (s *Service) GetAbility(ctx context.Context, idOrName string) (*dto.AbilityResponse, error) {
+	query, arg, ok := byIDOrName(idOrName)
+	if !ok {
+		return nil, repository.ErrAbilityNotFound
+	}
+
+	ability, err := s.repo.GetAbility(ctx, query, arg)
+	if err != nil {
+		return nil, err
+	}
+
+	translations, err := s.abilityTranslations(ctx, []int{ability.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	response := abilityResponse(*ability, translations[ability.ID])
+	return &response, nil
+}
+
+func (s *Service) ListItems(ctx context.Context, filter repository.ItemFilter, page, pageSize int) (*dto.ItemListResponse, error) {
+	if err := checkPagination(page, pageSize); err != nil {
+		return nil, err
+	}
+
+	filter.Category = strings.ToLower(strings.TrimSpace(filter.Category))
+
+	items, total, err := s.repo.ListItems(ctx, filter, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ItemListResponse{Items: items, Page: page, PageSize: pageSize, Total: total}, nil
+}
+
+// GetItem looks an item up by id or by name.
+func (s *Service) GetItem(ctx context.Context, idOrName string) (*model.Item, error) {
+	query, arg, ok := byIDOrName(idOrName)
+	if !ok {
+		return nil, repository.ErrItemNotFound
+	}
+
+	return s.repo.GetItem(ctx, query, arg)
+}
+
+func checkPagination(page, pageSize int) error {
+	if page < 1 || pageSize < 1 || pageSize > MaxPageSize {
+		return ErrInvalidPagination
+	}
+
+	return nil
+}
+
+// byIDOrName returns the condition matching a numeric id or a name; ok is
+// false for an empty value.
+func byIDOrName(idOrName string) (query string, arg interface{}, ok bool) {
+	idOrName = strings.ToLower(strings.TrimSpace(idOrName))
+	if idOrName == "" {
+		return "", nil, false
+	}
+
+	if id, err := strconv.Atoi(idOrName); err == nil {
+		return "id = ?", id, true
+	}
+
+	return "name = ?", idOrName, true
+}
+
+// moveTranslations returns the translation of each move in the language of
+// the context, every field falling back to the default language when it is
+// missing.
+func (s *Service) moveTranslations(ctx context.Context, ids []int) (map[int]model.MoveTranslation, error) {
+	rows, err := s.repo.MoveTranslations(ctx, ids, i18n.Languages(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	requested := i18n.Code(i18n.FromContext(ctx))
+	merged := make(map[int]model.MoveTranslation, len(ids))
+	for _, preferred := range []bool{true, false} {
+		for _, row := range rows {
+			if (row.Language == requested) != preferred {
+				continue
+			}
+			m := merged[row.MoveID]
+			m.Name = i18n.Pick(m.Name, row.Name)
+			m.FlavorText = i18n.Pick(m.FlavorText, row.FlavorText)
+			merged[row.MoveID] = m
+		}
+	}
+
+	return merged, nil
+}
+
+// abilityTranslations is moveTranslations for abilities.
+func (s *Service) abilityTranslations(ctx context.Context, ids []int) (map[int]model.AbilityTranslation, error) {
+	rows, err := s.repo.AbilityTranslations(ctx, ids, i18n.Languages(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	requested := i18n.Code(i18n.FromContext(ctx))
+	merged := make(map[int]model.AbilityTranslation, len(ids))
+	for _, preferred := range []bool{true, false} {
+		for _, row := range rows {
+			if (row.Language == requested) != preferred {
+				continue
+			}
+			a := merged[row.AbilityID]
+			a.Name = i18n.Pick(a.Name, row.Name)
+			a.FlavorText = i18n.Pick(a.FlavorText, row.FlavorText)
+			merged[row.AbilityID] = a
+		}
+	}
+
+	return merged, nil
+}
+
+func abilityResponse(a model.Ability, translation model.AbilityTranslation) dto.AbilityResponse {
+	return dto.AbilityResponse{
+		ID:           a.ID,
+		Name:         a.Name,
+		DisplayName:  i18n.Pick(translation.Name, a.Name),
+		Generation:   a.Generation,
+		IsMainSeries: a.IsMainSeries,
+		ShortEffect:  a.ShortEffect,
+		Effect:       a.Effect,
+		FlavorText:   translation.FlavorText,
+	}
+}
+
+func moveResponse(m model.Move, translation model.MoveTranslation) dto.MoveResponse {
+	response := dto.MoveResponse{
+		ID:           m.ID,
+		Name:         m.Name,
+		DisplayName:  i18n.Pick(translation.Name, m.Name),
+		FlavorText:   translation.FlavorText,
+		Generation:   m.Generation,
+		DamageClass:  m.DamageClass,
+		Power:        m.Power,
+		Accuracy:     m.Accuracy,
+		PP:           m.PP,
+		Priority:     m.Priority,
+		EffectChance: m.EffectChance,
+		ShortEffect:  m.ShortEffect,
+		Effect:       m.Effect,
+	}
+	if m.Type != nil {
+		response.Type = m.Type.Name
+	}
+
+	return response
+}
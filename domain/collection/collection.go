@@ -0,0 +1,21 @@
+package collection
+
+import (
+	"pokedex_backend_go/domain/collection/handler"
+	"pokedex_backend_go/domain/collection/repository"
+	"pokedex_backend_go/domain/collection/service"
+	"pokedex_backend_go/pkg/server"
+
+	"go.uber.org/fx"
+)
+
+func CollectionProvider() fx.Option {
+	return fx.Options(
+		fx.Provide(
+			repository.NewRepository,
+			service.NewService,
+			server.AsHandler(handler.Handler),
+			handler.NewHandler,
+		),
+	)
+}
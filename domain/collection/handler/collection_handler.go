@@ -0,0 +1,258 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"pokedex_backend_go/domain/collection/service"
+	"pokedex_backend_go/pkg/auth"
+	"pokedex_backend_go/pkg/logger"
+	"pokedex_backend_go/pkg/problem"
+	"pokedex_backend_go/pkg/request"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+type CollectionHandler struct {
+	service *service.Service
+}
+
+func NewHandler(service *service.Service) *CollectionHandler {
+	return &CollectionHandler{
+		service: service,
+	}
+}
+
+func Handler(service *service.Service, authMiddleware *auth.AuthMiddleware) func(chi.Router) {
+	return func(r chi.Router) {
+		logger := zap.L().Named("collection_handler_registration")
+		logger.Info("Registering collection handler at /api/v1/me/pokedex")
+
+		handler := NewHandler(service)
+
+		r.With(authMiddleware.RequireAuth).Get("/api/v1/me/pokedex", handler.ListEntries)
+		r.With(authMiddleware.RequireAuth).Get("/api/v1/me/pokedex/progress", handler.GetProgress)
+		r.With(authMiddleware.RequireAuth).Post("/api/v1/me/pokedex/bulk", handler.BulkRequest)
+		r.With(authMiddleware.RequireAuth).Put("/api/v1/me/pokedex/{speciesID}/{status}", handler.MarkRequest)
+		r.With(authMiddleware.RequireAuth).Delete("/api/v1/me/pokedex/{speciesID}/{status}", handler.UnmarkRequest)
+	}
+}
+
+type MarkPayload struct {
+	FormID   *int       `json:"form_id"`
+	Version  string     `json:"version"`
+	MarkedAt *time.Time `json:"marked_at"`
+}
+
+type BulkMarkPayload struct {
+	SpeciesID int        `json:"species_id"`
+	FormID    *int       `json:"form_id"`
+	Status    string     `json:"status"`
+	Version   string     `json:"version"`
+	MarkedAt  *time.Time `json:"marked_at"`
+}
+
+type BulkUnmarkPayload struct {
+	SpeciesID int    `json:"species_id"`
+	FormID    *int   `json:"form_id"`
+	Status    string `json:"status"`
+}
+
+type BulkPayload struct {
+	Mark   []BulkMarkPayload   `json:"mark"`
+	Unmark []BulkUnmarkPayload `json:"unmark"`
+}
+
+func (handler *CollectionHandler) ListEntries(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth.GetUserFromContext(r.Context())
+	if !ok {
+		logger.FromContext(r.Context()).Error("Failed to get user from context")
+		problem.Write(w, r, problem.ErrInternal)
+		return
+	}
+
+	query := r.URL.Query()
+
+	speciesID := 0
+	if value := query.Get("species_id"); value != "" {
+		id, err := strconv.Atoi(value)
+		if err != nil {
+			problem.Write(w, r, service.ErrInvalidSpeciesID)
+			return
+		}
+		speciesID = id
+	}
+
+	ctx := r.Context()
+	response, err := handler.service.List(ctx, claims.UserID, query.Get("status"), speciesID, query.Get("version"))
+	if err != nil {
+		handler.writeError(w, r, err, "Failed to list pokedex entries")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.FromContext(ctx).Error("Failed to encode pokedex list response", zap.Error(err))
+	}
+}
+
+func (handler *CollectionHandler) GetProgress(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth.GetUserFromContext(r.Context())
+	if !ok {
+		logger.FromContext(r.Context()).Error("Failed to get user from context")
+		problem.Write(w, r, problem.ErrInternal)
+		return
+	}
+
+	ctx := r.Context()
+	response, err := handler.service.Progress(ctx, claims.UserID)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to get pokedex progress", zap.Error(err))
+		problem.Write(w, r, problem.ErrInternal)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.FromContext(ctx).Error("Failed to encode pokedex progress response", zap.Error(err))
+	}
+}
+
+func (handler *CollectionHandler) MarkRequest(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth.GetUserFromContext(r.Context())
+	if !ok {
+		logger.FromContext(r.Context()).Error("Failed to get user from context")
+		problem.Write(w, r, problem.ErrInternal)
+		return
+	}
+
+	speciesID, err := strconv.Atoi(chi.URLParam(r, "speciesID"))
+	if err != nil {
+		problem.Write(w, r, service.ErrInvalidSpeciesID)
+		return
+	}
+
+	// The body is optional: without one the species itself is marked now.
+	var req MarkPayload
+	if err := request.DecodeOptional(w, r, &req); err != nil {
+		problem.Write(w, r, err)
+		return
+	}
+
+	ctx := r.Context()
+	response, err := handler.service.Mark(ctx, claims.UserID, service.Mark{
+		SpeciesID: speciesID,
+		FormID:    req.FormID,
+		Status:    chi.URLParam(r, "status"),
+		Version:   req.Version,
+		MarkedAt:  req.MarkedAt,
+	})
+	if err != nil {
+		handler.writeError(w, r, err, "Failed to mark pokedex entry")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.FromContext(ctx).Error("Failed to encode pokedex entry response", zap.Error(err))
+	}
+}
+
+func (handler *CollectionHandler) UnmarkRequest(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth.GetUserFromContext(r.Context())
+	if !ok {
+		logger.FromContext(r.Context()).Error("Failed to get user from context")
+		problem.Write(w, r, problem.ErrInternal)
+		return
+	}
+
+	speciesID, err := strconv.Atoi(chi.URLParam(r, "speciesID"))
+	if err != nil {
+		problem.Write(w, r, service.ErrInvalidSpeciesID)
+		return
+	}
+
+	var formID *int
+	if value := r.URL.Query().Get("form_id"); value != "" {
+		id, err := strconv.Atoi(value)
+		if err != nil {
+			problem.Write(w, r, service.ErrInvalidFormID)
+			return
+		}
+		formID = &id
+	}
+
+	ctx := r.Context()
+	err = handler.service.Unmark(ctx, claims.UserID, service.Unmark{
+		SpeciesID: speciesID,
+		FormID:    formID,
+		Status:    chi.URLParam(r, "status"),
+	})
+	if err != nil {
+		handler.writeError(w, r, err, "Failed to unmark pokedex entry")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (handler *CollectionHandler) BulkRequest(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth.GetUserFromContext(r.Context())
+	if !ok {
+		logger.FromContext(r.Context()).Error("Failed to get user from context")
+		problem.Write(w, r, problem.ErrInternal)
+		return
+	}
+
+	var req BulkPayload
+	if err := request.Decode(w, r, &req); err != nil {
+		problem.Write(w, r, err)
+		return
+	}
+
+	marks := make([]service.Mark, 0, len(req.Mark))
+	for _, m := range req.Mark {
+		marks = append(marks, service.Mark{
+			SpeciesID: m.SpeciesID,
+			FormID:    m.FormID,
+			Status:    m.Status,
+			Version:   m.Version,
+			MarkedAt:  m.MarkedAt,
+		})
+	}
+
+	unmarks := make([]service.Unmark, 0, len(req.Unmark))
+	for _, u := range req.Unmark {
+		unmarks = append(unmarks, service.Unmark{
+			SpeciesID: u.SpeciesID,
+			FormID:    u.FormID,
+			Status:    u.Status,
+		})
+	}
+
+	ctx := r.Context()
+	response, err := handler.service.Bulk(ctx, claims.UserID, marks, unmarks)
+	if err != nil {
+		handler.writeError(w, r, err, "Failed to sync pokedex entries")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.FromContext(ctx).Error("Failed to encode pokedex bulk response", zap.Error(err))
+	}
+}
+
+func (handler *CollectionHandler) writeError(w http.ResponseWriter, r *http.Request, err error, message string) {
+	if problem.Internal(err) {
+		logger.FromContext(r.Context()).Error(message, zap.Error(err))
+	}
+	problem.Write(w, r, err)
+}
@@ -0,0 +1,317 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"pokedex_backend_go/pkg/database"
+	"pokedex_backend_go/pkg/logger"
+	"pokedex_backend_go/pkg/model"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrEntryNotFound = errors.New("pokedex entry not found")
+
+// entryKey matches the unique index on user_pokedex_entries, whose NULLS NOT
+// DISTINCT lets a NULL form_id conflict like any other value.
+var entryKey = []clause.Column{{Name: "user_id"}, {Name: "species_id"}, {Name: "form_id"}, {Name: "status"}}
+
+// progressQuery computes every progress group in one round trip. A species
+// counts as seen with any mark, as caught (owned, i.e. in the living dex) with
+// a caught or shiny mark on the species or any of its forms, and as shiny with
+// a shiny mark. Types come from the default variety of each species.
+const progressQuery = `
+WITH marks AS (
+    SELECT species_id,
+           BOOL_OR(status IN ('caught', 'shiny')) AS caught,
+           BOOL_OR(status = 'shiny') AS shiny
+    FROM user_pokedex_entries
+    WHERE user_id = ?
+    GROUP BY species_id
+),
+species AS (
+    SELECT s.id, s.generation,
+           m.species_id IS NOT NULL AS seen,
+           COALESCE(m.caught, FALSE) AS caught,
+           COALESCE(m.shiny, FALSE) AS shiny
+    FROM pokemon_species s
+    LEFT JOIN marks m ON m.species_id = s.id
+),
+groups AS (
+    SELECT 'overall' AS kind, '' AS name, 0 AS position,
+           COUNT(*) AS total,
+           COUNT(*) FILTER (WHERE seen) AS seen,
+           COUNT(*) FILTER (WHERE caught) AS caught,
+           COUNT(*) FILTER (WHERE shiny) AS shiny
+    FROM species
+    UNION ALL
+    SELECT 'generation', generation::TEXT, generation,
+           COUNT(*),
+           COUNT(*) FILTER (WHERE seen),
+           COUNT(*) FILTER (WHERE caught),
+           COUNT(*) FILTER (WHERE shiny)
+    FROM species
+    GROUP BY generation
+    UNION ALL
+    SELECT 'pokedex', d.name, d.id,
+           COUNT(*),
+           COUNT(*) FILTER (WHERE sp.seen),
+           COUNT(*) FILTER (WHERE sp.caught),
+           COUNT(*) FILTER (WHERE sp.shiny)
+    FROM pokedex_numbers n
+    JOIN pokedexes d ON d.id = n.pokedex_id
+    JOIN species sp ON sp.id = n.species_id
+    WHERE d.is_main_series
+    GROUP BY d.id, d.name
+    UNION ALL
+    SELECT 'type', t.name, t.id,
+           COUNT(*),
+           COUNT(*) FILTER (WHERE sp.seen),
+           COUNT(*) FILTER (WHERE sp.caught),
+           COUNT(*) FILTER (WHERE sp.shiny)
+    FROM species sp
+    JOIN pokemon p ON p.species_id = sp.id AND p.is_default
+    JOIN pokemon_types pt ON pt.pokemon_id = p.id
+    JOIN types t ON t.id = pt.type_id
+    GROUP BY t.id, t.name
+)
+SELECT kind, name, total, seen, caught, shiny,
+       COALESCE(ROUND(100.0 * caught / NULLIF(total, 0), 2), 0)::FLOAT8 AS percent,
+       COALESCE(ROUND(100.0 * shiny / NULLIF(total, 0), 2), 0)::FLOAT8 AS shiny_percent
+FROM groups
+ORDER BY kind, position`
+
+// ProgressRow holds the counts of one progress group, e.g. kind "generation"
+// and name "1".
+type ProgressRow struct {
+	Kind         string
+	Name         string
+	Total        int
+	Seen         int
+	Caught       int
+	Shiny        int
+	Percent      float64
+	ShinyPercent float64
+}
+
+type EntryFilter struct {
+	Status    string
+	SpeciesID int
+	VersionID int
+}
+
+func NewRepository() *Repository {
+	return &Repository{}
+}
+
+type Repository struct {
+}
+
+func (r *Repository) ListEntries(ctx context.Context, userID string, filter EntryFilter) ([]model.PokedexEntry, error) {
+	orm := database.Orm(ctx)
+
+	query := orm.WithContext(ctx).Where("user_id = ?", userID)
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.SpeciesID != 0 {
+		query = query.Where("species_id = ?", filter.SpeciesID)
+	}
+	if filter.VersionID != 0 {
+		query = query.Where("version_id = ?", filter.VersionID)
+	}
+
+	var entries []model.PokedexEntry
+	result := query.
+		Preload("Species").
+		Preload("Form").
+		Preload("Version").
+		Order("species_id, form_id NULLS FIRST, status").
+		Find(&entries)
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to list pokedex entries", zap.String("user_id", userID), zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	return entries, nil
+}
+
+func (r *Repository) GetEntry(ctx context.Context, userID string, speciesID int, formID *int, status string) (*model.PokedexEntry, error) {
+	orm := database.Orm(ctx)
+
+	var entry model.PokedexEntry
+	result := orm.WithContext(ctx).
+		Preload("Species").
+		Preload("Form").
+		Preload("Version").
+		Where("user_id = ? AND species_id = ? AND form_id IS NOT DISTINCT FROM ? AND status = ?", userID, speciesID, formID, status).
+		First(&entry)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrEntryNotFound
+		}
+		logger.FromContext(ctx).Error("Failed to get pokedex entry", zap.String("user_id", userID), zap.Int("species_id", speciesID), zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	return &entry, nil
+}
+
+// UpsertEntries writes the entries, overwriting the version and timestamp of
+// marks the user already had.
+func (r *Repository) UpsertEntries(ctx context.Context, entries []model.PokedexEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	orm := database.Orm(ctx)
+
+	result := orm.WithContext(ctx).
+		Omit(clause.Associations).
+		Clauses(clause.OnConflict{
+			Columns: entryKey,
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"version_id": gorm.Expr("EXCLUDED.version_id"),
+				"marked_at":  gorm.Expr("EXCLUDED.marked_at"),
+				"updated_at": time.Now(),
+			}),
+		}).
+		Create(&entries)
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to upsert pokedex entries", zap.Int("count", len(entries)), zap.Error(result.Error))
+		return result.Error
+	}
+
+	return nil
+}
+
+// InsertMissingEntries writes the entries the user does not have yet and
+// leaves existing ones untouched.
+func (r *Repository) InsertMissingEntries(ctx context.Context, entries []model.PokedexEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	orm := database.Orm(ctx)
+
+	result := orm.WithContext(ctx).
+		Omit(clause.Associations).
+		Clauses(clause.OnConflict{Columns: entryKey, DoNothing: true}).
+		Create(&entries)
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to insert pokedex entries", zap.Int("count", len(entries)), zap.Error(result.Error))
+		return result.Error
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteEntries(ctx context.Context, userID string, speciesID int, formID *int, statuses []string) (int64, error) {
+	orm := database.Orm(ctx)
+
+	result := orm.WithContext(ctx).
+		Where("user_id = ? AND species_id = ? AND form_id IS NOT DISTINCT FROM ? AND status IN ?", userID, speciesID, formID, statuses).
+		Delete(&model.PokedexEntry{})
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to delete pokedex entries", zap.String("user_id", userID), zap.Int("species_id", speciesID), zap.Error(result.Error))
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+func (r *Repository) Progress(ctx context.Context, userID string) ([]ProgressRow, error) {
+	orm := database.Orm(ctx)
+
+	var rows []ProgressRow
+	result := orm.WithContext(ctx).Raw(progressQuery, userID).Scan(&rows)
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to compute pokedex progress", zap.String("user_id", userID), zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	return rows, nil
+}
+
+// ExistingSpecies returns which of the given species ids exist.
+func (r *Repository) ExistingSpecies(ctx context.Context, ids []int) (map[int]bool, error) {
+	existing := make(map[int]bool, len(ids))
+	if len(ids) == 0 {
+		return existing, nil
+	}
+
+	orm := database.Orm(ctx)
+
+	var found []int
+	result := orm.WithContext(ctx).Model(&model.PokemonSpecies{}).Where("id IN ?", ids).Pluck("id", &found)
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to look up species", zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	for _, id := range found {
+		existing[id] = true
+	}
+
+	return existing, nil
+}
+
+// FormSpecies maps the given form ids to the species they belong to. Unknown
+// forms are left out.
+func (r *Repository) FormSpecies(ctx context.Context, formIDs []int) (map[int]int, error) {
+	species := make(map[int]int, len(formIDs))
+	if len(formIDs) == 0 {
+		return species, nil
+	}
+
+	orm := database.Orm(ctx)
+
+	var rows []struct {
+		ID        int
+		SpeciesID int
+	}
+	result := orm.WithContext(ctx).
+		Table("pokemon_forms").
+		Select("pokemon_forms.id, pokemon.species_id").
+		Joins("JOIN pokemon ON pokemon.id = pokemon_forms.pokemon_id").
+		Where("pokemon_forms.id IN ?", formIDs).
+		Scan(&rows)
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to look up forms", zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	for _, row := range rows {
+		species[row.ID] = row.SpeciesID
+	}
+
+	return species, nil
+}
+
+// VersionIDs maps the given version names to their ids. Unknown names are
+// left out.
+func (r *Repository) VersionIDs(ctx context.Context, names []string) (map[string]int, error) {
+	ids := make(map[string]int, len(names))
+	if len(names) == 0 {
+		return ids, nil
+	}
+
+	orm := database.Orm(ctx)
+
+	var versions []model.Version
+	result := orm.WithContext(ctx).Where("name IN ?", names).Find(&versions)
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to look up versions", zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	for _, version := range versions {
+		ids[version.Name] = version.ID
+	}
+
+	return ids, nil
+}
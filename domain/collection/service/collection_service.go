@@ -0,0 +1,418 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"pokedex_backend_go/domain/collection/repository"
+	"pokedex_backend_go/pkg/database"
+	"pokedex_backend_go/pkg/dto"
+	"pokedex_backend_go/pkg/i18n"
+	"pokedex_backend_go/pkg/logger"
+	"pokedex_backend_go/pkg/model"
+	"pokedex_backend_go/pkg/problem"
+
+	"go.uber.org/zap"
+)
+
+// MaxBulkEntries bounds a bulk request; a whole game's worth of boxes fits
+// comfortably.
+const MaxBulkEntries = 1000
+
+// clockSkew is how far in the future a client supplied marked_at may be.
+const clockSkew = time.Minute
+
+var (
+	ErrInvalidStatus    = problem.New(http.StatusBadRequest, "invalid_status", "status must be seen, caught or shiny")
+	ErrInvalidSpeciesID = problem.New(http.StatusBadRequest, "invalid_species_id", "species_id must be a number")
+	ErrInvalidFormID    = problem.New(http.StatusBadRequest, "invalid_form_id", "form_id must be a number")
+	ErrSpeciesNotFound  = problem.New(http.StatusBadRequest, "species_not_found", "species not found")
+	ErrFormNotFound     = problem.New(http.StatusBadRequest, "form_not_found", "form not found for this species")
+	ErrVersionNotFound  = problem.New(http.StatusBadRequest, "unknown_version", "unknown game version")
+	ErrMarkedInFuture   = problem.New(http.StatusBadRequest, "marked_in_future", "marked_at cannot be in the future")
+	ErrTooManyEntries   = problem.New(http.StatusBadRequest, "too_many_entries", "too many entries")
+)
+
+// implied lists the marks that come with a status: a caught pokemon has been
+// seen, and a shiny one has been caught.
+var implied = map[string][]string{
+	model.PokedexStatusSeen:   nil,
+	model.PokedexStatusCaught: {model.PokedexStatusSeen},
+	model.PokedexStatusShiny:  {model.PokedexStatusCaught, model.PokedexStatusSeen},
+}
+
+// cleared lists the marks removed with a status, so unmarking never leaves a
+// pokemon caught but not seen.
+var cleared = map[string][]string{
+	model.PokedexStatusSeen:   {model.PokedexStatusSeen, model.PokedexStatusCaught, model.PokedexStatusShiny},
+	model.PokedexStatusCaught: {model.PokedexStatusCaught, model.PokedexStatusShiny},
+	model.PokedexStatusShiny:  {model.PokedexStatusShiny},
+}
+
+// Mark sets a status on a species, or on one of its forms when FormID is set.
+// Version is a game version name such as "scarlet" and MarkedAt defaults to
+// now.
+type Mark struct {
+	SpeciesID int
+	FormID    *int
+	Status    string
+	Version   string
+	MarkedAt  *time.Time
+}
+
+type Unmark struct {
+	SpeciesID int
+	FormID    *int
+	Status    string
+}
+
+func NewService(repo *repository.Repository) *Service {
+	return &Service{
+		repo: repo,
+	}
+}
+
+type Service struct {
+	repo *repository.Repository
+}
+
+func (s *Service) List(ctx context.Context, userID, status string, speciesID int, version string) (*dto.PokedexListResponse, error) {
+	filter := repository.EntryFilter{SpeciesID: speciesID}
+
+	if status != "" {
+		status = normalize(status)
+		if _, ok := implied[status]; !ok {
+			return nil, ErrInvalidStatus
+		}
+		filter.Status = status
+	}
+
+	if version != "" {
+		version = normalize(version)
+		ids, err := s.repo.VersionIDs(ctx, []string{version})
+		if err != nil {
+			return nil, err
+		}
+		if ids[version] == 0 {
+			return nil, ErrVersionNotFound
+		}
+		filter.VersionID = ids[version]
+	}
+
+	entries, err := s.repo.ListEntries(ctx, userID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.PokedexListResponse{
+		Entries: make([]dto.PokedexEntryResponse, 0, len(entries)),
+		Total:   len(entries),
+	}
+	for i := range entries {
+		response.Entries = append(response.Entries, toResponse(&entries[i]))
+	}
+
+	return response, nil
+}
+
+func (s *Service) Mark(ctx context.Context, userID string, mark Mark) (*dto.PokedexEntryResponse, error) {
+	mark.Status = normalize(mark.Status)
+	mark.Version = normalize(mark.Version)
+
+	refs, err := s.lookup(ctx, []Mark{mark})
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := refs.entry(userID, mark, time.Now())
+	if err != nil {
+		logger.FromContext(ctx).Debug("Invalid pokedex mark", zap.String("user_id", userID), zap.Int("species_id", mark.SpeciesID), zap.Error(err))
+		return nil, err
+	}
+
+	var saved *model.PokedexEntry
+	err = database.Transactional(ctx, func(ctx context.Context) error {
+		if err := s.write(ctx, []model.PokedexEntry{entry}); err != nil {
+			return err
+		}
+
+		saved, err = s.repo.GetEntry(ctx, userID, entry.SpeciesID, entry.FormID, entry.Status)
+		return err
+	})
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to mark pokedex entry", zap.String("user_id", userID), zap.Int("species_id", mark.SpeciesID), zap.Error(err))
+		return nil, err
+	}
+
+	response := toResponse(saved)
+	return &response, nil
+}
+
+// Unmark removes a status and the ones it implies the other way round, so
+// unmarking "seen" also clears "caught" and "shiny". Removing a mark that does
+// not exist is not an error.
+func (s *Service) Unmark(ctx context.Context, userID string, unmark Unmark) error {
+	unmark.Status = normalize(unmark.Status)
+	if _, ok := cleared[unmark.Status]; !ok {
+		return ErrInvalidStatus
+	}
+
+	_, err := s.repo.DeleteEntries(ctx, userID, unmark.SpeciesID, unmark.FormID, cleared[unmark.Status])
+	return err
+}
+
+// Bulk applies every unmark and then every mark in a single transaction, so
+// a box is either synced entirely or not at all. Errors name the offending
+// entry by its position in the request.
+func (s *Service) Bulk(ctx context.Context, userID string, marks []Mark, unmarks []Unmark) (*dto.PokedexBulkResponse, error) {
+	if len(marks)+len(unmarks) > MaxBulkEntries {
+		return nil, i18n.Errorf(ErrTooManyEntries, "at most %d entries can be sent at once", MaxBulkEntries)
+	}
+
+	for i := range unmarks {
+		unmarks[i].Status = normalize(unmarks[i].Status)
+		if _, ok := cleared[unmarks[i].Status]; !ok {
+			return nil, problem.Field(fmt.Sprintf("unmark[%d]", i), ErrInvalidStatus)
+		}
+	}
+
+	for i := range marks {
+		marks[i].Status = normalize(marks[i].Status)
+		marks[i].Version = normalize(marks[i].Version)
+	}
+
+	refs, err := s.lookup(ctx, marks)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	entries := make([]model.PokedexEntry, 0, len(marks))
+	for i, mark := range marks {
+		entry, err := refs.entry(userID, mark, now)
+		if err != nil {
+			return nil, problem.Field(fmt.Sprintf("mark[%d]", i), err)
+		}
+		entries = append(entries, entry)
+	}
+
+	response := &dto.PokedexBulkResponse{}
+	err = database.Transactional(ctx, func(ctx context.Context) error {
+		for _, unmark := range unmarks {
+			deleted, err := s.repo.DeleteEntries(ctx, userID, unmark.SpeciesID, unmark.FormID, cleared[unmark.Status])
+			if err != nil {
+				return err
+			}
+			response.Unmarked += deleted
+		}
+
+		if err := s.write(ctx, entries); err != nil {
+			return err
+		}
+		response.Marked = len(entries)
+
+		return nil
+	})
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to sync pokedex entries", zap.String("user_id", userID), zap.Error(err))
+		return nil, err
+	}
+
+	logger.FromContext(ctx).Info("Pokedex entries synced",
+		zap.String("user_id", userID),
+		zap.Int("marked", response.Marked),
+		zap.Int64("unmarked", response.Unmarked),
+	)
+	return response, nil
+}
+
+func (s *Service) Progress(ctx context.Context, userID string) (*dto.PokedexProgressResponse, error) {
+	rows, err := s.repo.Progress(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.PokedexProgressResponse{
+		Generations: []dto.GenerationProgress{},
+		Pokedexes:   []dto.PokedexProgress{},
+		Types:       []dto.TypeProgress{},
+	}
+	for _, row := range rows {
+		counts := dto.ProgressCounts{
+			Total:        row.Total,
+			Seen:         row.Seen,
+			Caught:       row.Caught,
+			Shiny:        row.Shiny,
+			Percent:      row.Percent,
+			ShinyPercent: row.ShinyPercent,
+		}
+
+		switch row.Kind {
+		case "overall":
+			response.Overall = counts
+		case "generation":
+			generation, err := strconv.Atoi(row.Name)
+			if err != nil {
+				return nil, fmt.Errorf("invalid generation %q: %w", row.Name, err)
+			}
+			response.Generations = append(response.Generations, dto.GenerationProgress{Generation: generation, ProgressCounts: counts})
+		case "pokedex":
+			response.Pokedexes = append(response.Pokedexes, dto.PokedexProgress{Pokedex: row.Name, ProgressCounts: counts})
+		case "type":
+			response.Types = append(response.Types, dto.TypeProgress{Type: row.Name, ProgressCounts: counts})
+		}
+	}
+
+	return response, nil
+}
+
+// write upserts the entries and adds the marks they imply without touching
+// the ones the user already has.
+func (s *Service) write(ctx context.Context, entries []model.PokedexEntry) error {
+	explicit := make(map[string]bool, len(entries))
+	unique := make([]model.PokedexEntry, 0, len(entries))
+	// The last mark for the same entry wins; a single INSERT ... ON CONFLICT
+	// DO UPDATE cannot touch the same row twice.
+	for i := len(entries) - 1; i >= 0; i-- {
+		key := entryKey(&entries[i], entries[i].Status)
+		if explicit[key] {
+			continue
+		}
+		explicit[key] = true
+		unique = append(unique, entries[i])
+	}
+
+	var missing []model.PokedexEntry
+	for _, entry := range unique {
+		for _, status := range implied[entry.Status] {
+			key := entryKey(&entry, status)
+			if explicit[key] {
+				continue
+			}
+			explicit[key] = true
+
+			extra := entry
+			extra.Status = status
+			missing = append(missing, extra)
+		}
+	}
+
+	if err := s.repo.UpsertEntries(ctx, unique); err != nil {
+		return err
+	}
+
+	return s.repo.InsertMissingEntries(ctx, missing)
+}
+
+// references holds what the marks of a request point at, looked up with one
+// query per table rather than one per mark.
+type references struct {
+	species     map[int]bool
+	formSpecies map[int]int
+	versions    map[string]int
+}
+
+func (s *Service) lookup(ctx context.Context, marks []Mark) (*references, error) {
+	var speciesIDs, formIDs []int
+	var versions []string
+	for _, mark := range marks {
+		speciesIDs = append(speciesIDs, mark.SpeciesID)
+		if mark.FormID != nil {
+			formIDs = append(formIDs, *mark.FormID)
+		}
+		if mark.Version != "" {
+			versions = append(versions, mark.Version)
+		}
+	}
+
+	refs := &references{}
+	var err error
+	if refs.species, err = s.repo.ExistingSpecies(ctx, speciesIDs); err != nil {
+		return nil, err
+	}
+	if refs.formSpecies, err = s.repo.FormSpecies(ctx, formIDs); err != nil {
+		return nil, err
+	}
+	if refs.versions, err = s.repo.VersionIDs(ctx, versions); err != nil {
+		return nil, err
+	}
+
+	return refs, nil
+}
+
+func (refs *references) entry(userID string, mark Mark, now time.Time) (model.PokedexEntry, error) {
+	entry := model.PokedexEntry{
+		UserID:    userID,
+		SpeciesID: mark.SpeciesID,
+		FormID:    mark.FormID,
+		Status:    mark.Status,
+		MarkedAt:  now,
+	}
+
+	if _, ok := implied[mark.Status]; !ok {
+		return entry, ErrInvalidStatus
+	}
+
+	if !refs.species[mark.SpeciesID] {
+		return entry, ErrSpeciesNotFound
+	}
+
+	if mark.FormID != nil && refs.formSpecies[*mark.FormID] != mark.SpeciesID {
+		return entry, ErrFormNotFound
+	}
+
+	if mark.Version != "" {
+		id, ok := refs.versions[mark.Version]
+		if !ok {
+			return entry, ErrVersionNotFound
+		}
+		entry.VersionID = &id
+	}
+
+	if mark.MarkedAt != nil {
+		if mark.MarkedAt.After(now.Add(clockSkew)) {
+			return entry, ErrMarkedInFuture
+		}
+		entry.MarkedAt = *mark.MarkedAt
+	}
+
+	return entry, nil
+}
+
+func entryKey(entry *model.PokedexEntry, status string) string {
+	form := 0
+	if entry.FormID != nil {
+		form = *entry.FormID
+	}
+
+	return fmt.Sprintf("%d/%d/%s", entry.SpeciesID, form, status)
+}
+
+func normalize(value string) string {
+	return strings.ToLower(strings.TrimSpace(value))
+}
+
+func toResponse(entry *model.PokedexEntry) dto.PokedexEntryResponse {
+	response := dto.PokedexEntryResponse{
+		SpeciesID: entry.SpeciesID,
+		FormID:    entry.FormID,
+		Status:    entry.Status,
+		MarkedAt:  entry.MarkedAt,
+	}
+
+	if entry.Species != nil {
+		response.SpeciesName = entry.Species.Name
+	}
+	if entry.Form != nil {
+		response.FormName = &entry.Form.Name
+	}
+	if entry.Version != nil {
+		response.Version = &entry.Version.Name
+	}
+
+	return response
+}
@@ -3,25 +3,25 @@ package repository
 import (
 	"context"
 	"errors"
+	"net/http"
 
 	"pokedex_backend_go/pkg/database"
+	"pokedex_backend_go/pkg/logger"
 	"pokedex_backend_go/pkg/model"
+	"pokedex_backend_go/pkg/problem"
 
 	"go.uber.org/zap"
 	"golang.org/x/crypto/bcrypt"
 	"gorm.io/gorm"
 )
 
-var ErrInvalidCredentials = errors.New("invalid username or password")
+var ErrInvalidCredentials = problem.New(http.StatusUnauthorized, "invalid_credentials", "Invalid email or password")
 
 func NewRepository() *Repository {
-	return &Repository{
-		logger: zap.L().Named("login_repository"),
-	}
+	return &Repository{}
 }
 
 type Repository struct {
-	logger *zap.Logger
 }
 
 func (r *Repository) Login(ctx context.Context, email, password string) (user *model.User, err error) {
@@ -31,20 +31,20 @@ func (r *Repository) Login(ctx context.Context, email, password string) (user *m
 	result := orm.WithContext(ctx).Where("email = ?", email).First(&foundUser)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
-			r.logger.Error("User not found", zap.String("email", email))
+			logger.FromContext(ctx).Error("User not found", zap.String("email", email))
 			return nil, ErrInvalidCredentials
 		}
-		r.logger.Error("Failed to find user", zap.String("email", email), zap.Error(result.Error))
+		logger.FromContext(ctx).Error("Failed to find user", zap.String("email", email), zap.Error(result.Error))
 		return nil, result.Error
 	}
 
 	err = bcrypt.CompareHashAndPassword([]byte(foundUser.Password), []byte(password))
 	if err != nil {
-		r.logger.Error("Invalid password", zap.String("email", email))
+		logger.FromContext(ctx).Error("Invalid password", zap.String("email", email))
 		return nil, ErrInvalidCredentials
 	}
 
-	r.logger.Info("User login successful", zap.String("email", email), zap.String("id", foundUser.ID))
+	logger.FromContext(ctx).Info("User login successful", zap.String("email", email), zap.String("id", foundUser.ID))
 
 	foundUser.Password = ""
 	return &foundUser, nil
@@ -2,61 +2,50 @@ package service
 
 import (
 	"context"
-	"errors"
 
 	repository "pokedex_backend_go/domain/login/repository"
-	"pokedex_backend_go/pkg/auth"
+	sessionService "pokedex_backend_go/domain/session/service"
+	"pokedex_backend_go/pkg/dto"
+	"pokedex_backend_go/pkg/logger"
 	"pokedex_backend_go/pkg/model"
 
 	"go.uber.org/zap"
 )
 
-func NewService(repo *repository.Repository) *Service {
+func NewService(repo *repository.Repository, sessions *sessionService.Service) *Service {
 	return &Service{
-		logger:     zap.L().Named("loginService"),
-		repo:       repo,
-		jwtService: auth.NewJWTService(),
+		repo:     repo,
+		sessions: sessions,
 	}
 }
 
 type Service struct {
-	logger     *zap.Logger
-	repo       *repository.Repository
-	jwtService *auth.JWTService
+	repo     *repository.Repository
+	sessions *sessionService.Service
 }
 
 func (s *Service) Login(ctx context.Context, email, password string) (user *model.User, err error) {
-	if email == "" {
-		s.logger.Error("Email is required")
-		return nil, errors.New("email is required")
-	}
-
-	if password == "" {
-		s.logger.Error("Password is required")
-		return nil, errors.New("password is required")
-	}
-
 	userData, err := s.repo.Login(ctx, email, password)
 	if err != nil {
-		s.logger.Error("Failed to login", zap.String("email", email), zap.Error(err))
+		logger.FromContext(ctx).Error("Failed to login", zap.String("email", email), zap.Error(err))
 		return nil, err
 	}
 
-	s.logger.Info("User login successful", zap.String("email", email), zap.String("id", userData.ID))
+	logger.FromContext(ctx).Info("User login successful", zap.String("email", email), zap.String("id", userData.ID))
 	return userData, nil
 }
 
-func (s *Service) LoginWithToken(ctx context.Context, email, password string) (user *model.User, token string, err error) {
+func (s *Service) LoginWithToken(ctx context.Context, email, password string) (user *model.User, tokens *dto.TokenResponse, err error) {
 	user, err = s.Login(ctx, email, password)
 	if err != nil {
-		return nil, "", err
+		return nil, nil, err
 	}
 
-	token, err = s.jwtService.GenerateToken(user)
+	tokens, err = s.sessions.IssueTokens(ctx, user)
 	if err != nil {
-		s.logger.Error("Failed to generate JWT token", zap.String("email", email), zap.Error(err))
-		return nil, "", err
+		logger.FromContext(ctx).Error("Failed to issue tokens", zap.String("email", email), zap.Error(err))
+		return nil, nil, err
 	}
 
-	return user, token, nil
+	return user, tokens, nil
 }
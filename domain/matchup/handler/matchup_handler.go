@@ -0,0 +1,144 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"pokedex_backend_go/domain/matchup/service"
+	"pokedex_backend_go/pkg/logger"
+	"pokedex_backend_go/pkg/problem"
+	"pokedex_backend_go/pkg/request"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+type MatchupHandler struct {
+	service *service.Service
+}
+
+func NewHandler(service *service.Service) *MatchupHandler {
+	return &MatchupHandler{
+		service: service,
+	}
+}
+
+func Handler(service *service.Service) func(chi.Router) {
+	return func(r chi.Router) {
+		logger := zap.L().Named("matchup_handler_registration")
+		logger.Info("Registering matchup handler at /api/v1/types")
+
+		handler := NewHandler(service)
+
+		r.Get("/api/v1/types/chart", handler.GetChart)
+		r.Get("/api/v1/types/defense", handler.GetDefense)
+		r.Post("/api/v1/types/coverage", handler.CoverageRequest)
+		r.Post("/api/v1/types/team", handler.TeamRequest)
+	}
+}
+
+type CoveragePayload struct {
+	Generation int      `json:"generation"`
+	Moves      []string `json:"moves"`
+	Types      []string `json:"types"`
+}
+
+type TeamPayload struct {
+	Generation int      `json:"generation"`
+	Pokemon    []string `json:"pokemon"`
+}
+
+func (handler *MatchupHandler) GetChart(w http.ResponseWriter, r *http.Request) {
+	generation, err := queryGeneration(r)
+	if err != nil {
+		problem.Write(w, r, service.ErrInvalidGeneration)
+		return
+	}
+
+	response, err := handler.service.Chart(generation)
+	if err != nil {
+		handler.writeError(w, r, err, "Failed to build type chart")
+		return
+	}
+
+	handler.writeJSON(w, r, response)
+}
+
+func (handler *MatchupHandler) GetDefense(w http.ResponseWriter, r *http.Request) {
+	generation, err := queryGeneration(r)
+	if err != nil {
+		problem.Write(w, r, service.ErrInvalidGeneration)
+		return
+	}
+
+	types := strings.Split(r.URL.Query().Get("types"), ",")
+
+	response, err := handler.service.Defense(generation, types)
+	if err != nil {
+		handler.writeError(w, r, err, "Failed to compute defensive matchups")
+		return
+	}
+
+	handler.writeJSON(w, r, response)
+}
+
+func (handler *MatchupHandler) CoverageRequest(w http.ResponseWriter, r *http.Request) {
+	var req CoveragePayload
+	if err := request.Decode(w, r, &req); err != nil {
+		problem.Write(w, r, err)
+		return
+	}
+
+	ctx := r.Context()
+	response, err := handler.service.Coverage(ctx, req.Generation, req.Moves, req.Types)
+	if err != nil {
+		handler.writeError(w, r, err, "Failed to compute coverage")
+		return
+	}
+
+	handler.writeJSON(w, r, response)
+}
+
+func (handler *MatchupHandler) TeamRequest(w http.ResponseWriter, r *http.Request) {
+	var req TeamPayload
+	if err := request.Decode(w, r, &req); err != nil {
+		problem.Write(w, r, err)
+		return
+	}
+
+	ctx := r.Context()
+	response, err := handler.service.Team(ctx, req.Generation, req.Pokemon)
+	if err != nil {
+		handler.writeError(w, r, err, "Failed to compute team matchups")
+		return
+	}
+
+	handler.writeJSON(w, r, response)
+}
+
+// queryGeneration reads ?generation=, where 0 stands for the latest one.
+func queryGeneration(r *http.Request) (int, error) {
+	value := r.URL.Query().Get("generation")
+	if value == "" {
+		return 0, nil
+	}
+
+	return strconv.Atoi(value)
+}
+
+func (handler *MatchupHandler) writeError(w http.ResponseWriter, r *http.Request, err error, message string) {
+	if problem.Internal(err) {
+		logger.FromContext(r.Context()).Error(message, zap.Error(err))
+	}
+	problem.Write(w, r, err)
+}
+
+func (handler *MatchupHandler) writeJSON(w http.ResponseWriter, r *http.Request, response any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.FromContext(r.Context()).Error("Failed to encode matchup response", zap.Error(err))
+	}
+}
@@ -0,0 +1,21 @@
+package matchup
+
+import (
+	"pokedex_backend_go/domain/matchup/handler"
+	"pokedex_backend_go/domain/matchup/repository"
+	"pokedex_backend_go/domain/matchup/service"
+	"pokedex_backend_go/pkg/server"
+
+	"go.uber.org/fx"
+)
+
+func MatchupProvider() fx.Option {
+	return fx.Options(
+		fx.Provide(
+			repository.NewRepository,
+			service.NewService,
+			server.AsHandler(handler.Handler),
+			handler.NewHandler,
+		),
+	)
+}
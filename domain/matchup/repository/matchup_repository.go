@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+
+	"pokedex_backend_go/pkg/database"
+	"pokedex_backend_go/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+type MoveType struct {
+	Name        string
+	Type        string
+	DamageClass string
+}
+
+func NewRepository() *Repository {
+	return &Repository{}
+}
+
+type Repository struct {
+}
+
+// MoveTypes returns the type and damage class of the given moves, keyed by
+// name. Unknown moves are left out.
+func (r *Repository) MoveTypes(ctx context.Context, names []string) (map[string]MoveType, error) {
+	found := make(map[string]MoveType, len(names))
+	if len(names) == 0 {
+		return found, nil
+	}
+
+	orm := database.Orm(ctx)
+
+	var rows []MoveType
+	result := orm.WithContext(ctx).
+		Table("moves").
+		Select("moves.name, types.name AS type, moves.damage_class").
+		Joins("JOIN types ON types.id = moves.type_id").
+		Where("moves.name IN ?", names).
+		Scan(&rows)
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to look up move types", zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	for _, row := range rows {
+		found[row.Name] = row
+	}
+
+	return found, nil
+}
+
+// PokemonTypes returns the types of the given varieties in slot order, keyed
+// by name. Species names resolve to their default variety, e.g. landorus to
+// landorus-incarnate. Unknown pokemon are left out.
+func (r *Repository) PokemonTypes(ctx context.Context, names []string) (map[string][]string, error) {
+	found := make(map[string][]string, len(names))
+	if len(names) == 0 {
+		return found, nil
+	}
+
+	orm := database.Orm(ctx)
+
+	var rows []struct {
+		Pokemon   string
+		Species   string
+		IsDefault bool
+		Type      string
+	}
+	result := orm.WithContext(ctx).
+		Table("pokemon").
+		Select("pokemon.name AS pokemon, pokemon_species.name AS species, pokemon.is_default, types.name AS type").
+		Joins("JOIN pokemon_species ON pokemon_species.id = pokemon.species_id").
+		Joins("JOIN pokemon_types ON pokemon_types.pokemon_id = pokemon.id").
+		Joins("JOIN types ON types.id = pokemon_types.type_id").
+		Where("pokemon.name IN ? OR (pokemon.is_default AND pokemon_species.name IN ?)", names, names).
+		Order("pokemon.name, pokemon_types.slot").
+		Scan(&rows)
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to look up pokemon types", zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	byVariety := make(map[string][]string, len(rows))
+	defaults := make(map[string]string)
+	for _, row := range rows {
+		byVariety[row.Pokemon] = append(byVariety[row.Pokemon], row.Type)
+		if row.IsDefault {
+			defaults[row.Species] = row.Pokemon
+		}
+	}
+
+	for _, name := range names {
+		if types, ok := byVariety[name]; ok {
+			found[name] = types
+		} else if variety, ok := defaults[name]; ok {
+			found[name] = byVariety[variety]
+		}
+	}
+
+	return found, nil
+}
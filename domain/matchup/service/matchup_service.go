@@ -0,0 +1,262 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"pokedex_backend_go/domain/matchup/repository"
+	"pokedex_backend_go/pkg/dto"
+	"pokedex_backend_go/pkg/i18n"
+	"pokedex_backend_go/pkg/identifier"
+	"pokedex_backend_go/pkg/model"
+	"pokedex_backend_go/pkg/problem"
+	"pokedex_backend_go/pkg/typechart"
+)
+
+const (
+	MaxMoves   = 24
+	MaxMembers = 6
+)
+
+var (
+	ErrInvalidGeneration = problem.New(http.StatusBadRequest, "invalid_generation", "generation must be a number")
+	ErrInvalidTypes      = problem.New(http.StatusBadRequest, "invalid_types", "between one and two distinct types are required")
+	ErrNoAttackers       = problem.New(http.StatusBadRequest, "no_attackers", "at least one damaging move or type is required")
+	ErrTooManyMoves      = problem.New(http.StatusBadRequest, "too_many_moves", "too many moves and types")
+	ErrInvalidTeamSize   = problem.New(http.StatusBadRequest, "invalid_team_size", "invalid team size")
+	ErrUnknownMove       = problem.New(http.StatusBadRequest, "unknown_move", "unknown move")
+	ErrUnknownPokemon    = problem.New(http.StatusBadRequest, "unknown_pokemon", "unknown pokemon")
+)
+
+func NewService(repo *repository.Repository) *Service {
+	return &Service{
+		repo: repo,
+	}
+}
+
+type Service struct {
+	repo *repository.Repository
+}
+
+// chart returns the chart of the generation, or the latest one for 0.
+func chart(generation int) (*typechart.Chart, error) {
+	if generation == 0 {
+		return typechart.Latest(), nil
+	}
+
+	return typechart.ForGeneration(generation)
+}
+
+func (s *Service) Chart(generation int) (*dto.TypeChartResponse, error) {
+	c, err := chart(generation)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.TypeChartResponse{
+		Generation: c.Generation(),
+		Types:      c.Types(),
+		Chart:      make(map[string]map[string]float64),
+	}
+	for _, attacking := range response.Types {
+		row := make(map[string]float64, len(response.Types))
+		for _, defending := range response.Types {
+			row[defending], _ = c.Factor(attacking, defending)
+		}
+		response.Chart[attacking] = row
+	}
+
+	return response, nil
+}
+
+// Defense returns what every attacking type does to a one or two type
+// combination.
+func (s *Service) Defense(generation int, types []string) (*dto.DefenseResponse, error) {
+	c, err := chart(generation)
+	if err != nil {
+		return nil, err
+	}
+
+	types = normalize(types)
+	if len(types) == 0 || len(types) > 2 || (len(types) == 2 && types[0] == types[1]) {
+		return nil, ErrInvalidTypes
+	}
+
+	multipliers, err := c.Defensive(types...)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.DefenseResponse{
+		Generation:  c.Generation(),
+		Types:       types,
+		Multipliers: multipliers,
+		Weaknesses:  []string{},
+		Resistances: []string{},
+		Immunities:  []string{},
+	}
+	for _, attacking := range c.Types() {
+		switch m := multipliers[attacking]; {
+		case m == 0:
+			response.Immunities = append(response.Immunities, attacking)
+		case m < 1:
+			response.Resistances = append(response.Resistances, attacking)
+		case m > 1:
+			response.Weaknesses = append(response.Weaknesses, attacking)
+		}
+	}
+
+	return response, nil
+}
+
+// Coverage reports how well a move set hits every type. Moves are looked up
+// in the catalog; status moves deal no damage and are listed as ignored.
+// Types can be passed directly for moves the catalog does not know yet.
+func (s *Service) Coverage(ctx context.Context, generation int, moves, types []string) (*dto.CoverageResponse, error) {
+	c, err := chart(generation)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(moves)+len(types) > MaxMoves {
+		return nil, i18n.Errorf(ErrTooManyMoves, "at most %d moves and types can be checked at once", MaxMoves)
+	}
+
+	moves = normalize(moves)
+	found, err := s.repo.MoveTypes(ctx, moves)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.CoverageResponse{
+		Generation:     c.Generation(),
+		AttackingTypes: []string{},
+		IgnoredMoves:   []string{},
+		SuperEffective: []string{},
+		Neutral:        []string{},
+		Resisted:       []string{},
+		NoEffect:       []string{},
+	}
+
+	seen := make(map[string]bool)
+	addType := func(t string) {
+		if !seen[t] {
+			seen[t] = true
+			response.AttackingTypes = append(response.AttackingTypes, t)
+		}
+	}
+
+	for _, name := range moves {
+		move, ok := found[name]
+		switch {
+		case !ok:
+			return nil, i18n.Errorf(ErrUnknownMove, "unknown move %q", name)
+		case move.DamageClass == model.DamageClassStatus:
+			response.IgnoredMoves = append(response.IgnoredMoves, name)
+		default:
+			addType(move.Type)
+		}
+	}
+	for _, t := range normalize(types) {
+		addType(t)
+	}
+
+	if len(response.AttackingTypes) == 0 {
+		return nil, ErrNoAttackers
+	}
+
+	response.Best, err = c.Offensive(response.AttackingTypes...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, defending := range c.Types() {
+		switch best := response.Best[defending]; {
+		case best == 0:
+			response.NoEffect = append(response.NoEffect, defending)
+		case best < 1:
+			response.Resisted = append(response.Resisted, defending)
+		case best == 1:
+			response.Neutral = append(response.Neutral, defending)
+		default:
+			response.SuperEffective = append(response.SuperEffective, defending)
+		}
+	}
+
+	return response, nil
+}
+
+// Team adds up the defensive matchups of a team. Members are varieties with
+// their current typing; abilities such as Levitate are not taken into
+// account.
+func (s *Service) Team(ctx context.Context, generation int, pokemon []string) (*dto.TeamMatchupResponse, error) {
+	c, err := chart(generation)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pokemon) == 0 || len(pokemon) > MaxMembers {
+		return nil, i18n.Errorf(ErrInvalidTeamSize, "a team has between 1 and %d pokemon", MaxMembers)
+	}
+
+	pokemon = normalize(pokemon)
+	found, err := s.repo.PokemonTypes(ctx, pokemon)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.TeamMatchupResponse{
+		Generation: c.Generation(),
+		Members:    make([]dto.MemberMatchup, 0, len(pokemon)),
+		Summary:    make([]dto.TypeMatchupSummary, 0, len(c.Types())),
+	}
+
+	for _, name := range pokemon {
+		types, ok := found[name]
+		if !ok {
+			return nil, i18n.Errorf(ErrUnknownPokemon, "unknown pokemon %q", name)
+		}
+
+		multipliers, err := c.Defensive(types...)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+
+		response.Members = append(response.Members, dto.MemberMatchup{
+			Pokemon:     name,
+			Types:       types,
+			Multipliers: multipliers,
+		})
+	}
+
+	for _, attacking := range c.Types() {
+		summary := dto.TypeMatchupSummary{Type: attacking}
+		for _, member := range response.Members {
+			switch m := member.Multipliers[attacking]; {
+			case m == 0:
+				summary.Immune++
+			case m < 1:
+				summary.Resist++
+			case m > 1:
+				summary.Weak++
+			default:
+				summary.Neutral++
+			}
+		}
+		response.Summary = append(response.Summary, summary)
+	}
+
+	return response, nil
+}
+
+func normalize(names []string) []string {
+	normalized := make([]string, 0, len(names))
+	for _, name := range names {
+		if name = identifier.Normalize(name); name != "" {
+			normalized = append(normalized, name)
+		}
+	}
+
+	return normalized
+}
@@ -0,0 +1,169 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"pokedex_backend_go/domain/pokemon/service"
+	"pokedex_backend_go/pkg/i18n"
+	"pokedex_backend_go/pkg/logger"
+	"pokedex_backend_go/pkg/problem"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+type PokemonHandler struct {
+	service *service.Service
+}
+
+func NewHandler(service *service.Service) *PokemonHandler {
+	return &PokemonHandler{
+		service: service,
+	}
+}
+
+func Handler(service *service.Service) func(chi.Router) {
+	return func(r chi.Router) {
+		logger := zap.L().Named("pokemon_handler_registration")
+		logger.Info("Registering pokemon handler at /api/v1/pokemon")
+
+		handler := NewHandler(service)
+
+		r.Get("/api/v1/pokemon", handler.ListPokemon)
+		r.Get("/api/v1/pokemon/search", handler.SearchPokemon)
+		r.Get("/api/v1/pokemon/{idOrName}", handler.GetPokemon)
+		r.Get("/api/v1/pokemon/{idOrName}/evolutions", handler.GetEvolutions)
+		r.Get("/api/v1/pokemon/{idOrName}/moves", handler.GetMoves)
+	}
+}
+
+func (handler *PokemonHandler) ListPokemon(w http.ResponseWriter, r *http.Request) {
+	page, err := queryInt(r, "page", 1)
+	if err != nil {
+		problem.Write(w, r, i18n.Errorf(service.ErrInvalidPagination, "page must be a number"))
+		return
+	}
+
+	pageSize, err := queryInt(r, "page_size", service.DefaultPageSize)
+	if err != nil {
+		problem.Write(w, r, i18n.Errorf(service.ErrInvalidPagination, "page_size must be a number"))
+		return
+	}
+
+	ctx := r.Context()
+	response, err := handler.service.List(ctx, page, pageSize)
+	if err != nil {
+		if problem.Internal(err) {
+			logger.FromContext(ctx).Error("Failed to list pokemon", zap.Error(err))
+		}
+		problem.Write(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.FromContext(ctx).Error("Failed to encode pokemon list response", zap.Error(err))
+	}
+}
+
+func (handler *PokemonHandler) SearchPokemon(w http.ResponseWriter, r *http.Request) {
+	page, err := queryInt(r, "page", 1)
+	if err != nil {
+		problem.Write(w, r, i18n.Errorf(service.ErrInvalidPagination, "page must be a number"))
+		return
+	}
+
+	pageSize, err := queryInt(r, "page_size", service.DefaultPageSize)
+	if err != nil {
+		problem.Write(w, r, i18n.Errorf(service.ErrInvalidPagination, "page_size must be a number"))
+		return
+	}
+
+	ctx := r.Context()
+	response, err := handler.service.Search(ctx, r.URL.Query().Get("q"), r.URL.Query().Get("sort"), page, pageSize)
+	if err != nil {
+		if problem.Internal(err) {
+			logger.FromContext(ctx).Error("Failed to search pokemon", zap.Error(err))
+		}
+		problem.Write(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.FromContext(ctx).Error("Failed to encode search response", zap.Error(err))
+	}
+}
+
+func (handler *PokemonHandler) GetPokemon(w http.ResponseWriter, r *http.Request) {
+	idOrName := chi.URLParam(r, "idOrName")
+
+	ctx := r.Context()
+	response, err := handler.service.Detail(ctx, idOrName)
+	if err != nil {
+		if problem.Internal(err) {
+			logger.FromContext(ctx).Error("Failed to get pokemon", zap.String("id_or_name", idOrName), zap.Error(err))
+		}
+		problem.Write(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.FromContext(ctx).Error("Failed to encode pokemon response", zap.Error(err))
+	}
+}
+
+func (handler *PokemonHandler) GetEvolutions(w http.ResponseWriter, r *http.Request) {
+	idOrName := chi.URLParam(r, "idOrName")
+
+	ctx := r.Context()
+	response, err := handler.service.Evolutions(ctx, idOrName)
+	if err != nil {
+		if problem.Internal(err) {
+			logger.FromContext(ctx).Error("Failed to get evolutions", zap.String("id_or_name", idOrName), zap.Error(err))
+		}
+		problem.Write(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.FromContext(ctx).Error("Failed to encode evolutions response", zap.Error(err))
+	}
+}
+
+func (handler *PokemonHandler) GetMoves(w http.ResponseWriter, r *http.Request) {
+	idOrName := chi.URLParam(r, "idOrName")
+
+	ctx := r.Context()
+	response, err := handler.service.Moves(ctx, idOrName, r.URL.Query().Get("version"))
+	if err != nil {
+		if problem.Internal(err) {
+			logger.FromContext(ctx).Error("Failed to get moves", zap.String("id_or_name", idOrName), zap.Error(err))
+		}
+		problem.Write(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.FromContext(ctx).Error("Failed to encode moves response", zap.Error(err))
+	}
+}
+
+func queryInt(r *http.Request, name string, fallback int) (int, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return fallback, nil
+	}
+
+	return strconv.Atoi(value)
+}
@@ -0,0 +1,21 @@
+package pokemon
+
+import (
+	"pokedex_backend_go/domain/pokemon/handler"
+	"pokedex_backend_go/domain/pokemon/repository"
+	"pokedex_backend_go/domain/pokemon/service"
+	"pokedex_backend_go/pkg/server"
+
+	"go.uber.org/fx"
+)
+
+func PokemonProvider() fx.Option {
+	return fx.Options(
+		fx.Provide(
+			repository.NewRepository,
+			service.NewService,
+			server.AsHandler(handler.Handler),
+			handler.NewHandler,
+		),
+	)
+}
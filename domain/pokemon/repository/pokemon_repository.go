@@ -0,0 +1,423 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"pokedex_backend_go/pkg/database"
+	"pokedex_backend_go/pkg/logger"
+	"pokedex_backend_go/pkg/model"
+	"pokedex_backend_go/pkg/problem"
+	"pokedex_backend_go/pkg/search"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrPokemonNotFound = problem.New(http.StatusNotFound, "pokemon_not_found", "Pokemon not found")
+	ErrVersionNotFound = problem.New(http.StatusBadRequest, "unknown_version", "Unknown version")
+)
+
+func NewRepository() *Repository {
+	return &Repository{}
+}
+
+type Repository struct {
+}
+
+func (r *Repository) ListSpecies(ctx context.Context, offset, limit int) (species []model.PokemonSpecies, total int64, err error) {
+	orm := database.Orm(ctx)
+
+	result := orm.WithContext(ctx).Model(&model.PokemonSpecies{}).Count(&total)
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to count pokemon species", zap.Error(result.Error))
+		return nil, 0, result.Error
+	}
+
+	result = orm.WithContext(ctx).
+		Preload("Varieties", "is_default = ?", true).
+		Preload("Varieties.Types", orderBySlot).
+		Preload("Varieties.Types.Type").
+		Order("id").
+		Offset(offset).
+		Limit(limit).
+		Find(&species)
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to list pokemon species", zap.Int("offset", offset), zap.Int("limit", limit), zap.Error(result.Error))
+		return nil, 0, result.Error
+	}
+
+	return species, total, nil
+}
+
+func (r *Repository) GetSpeciesByID(ctx context.Context, id int) (*model.PokemonSpecies, error) {
+	return r.getSpecies(ctx, "id = ?", id)
+}
+
+// GetSpeciesByName accepts both species names ("charizard") and variety
+// names ("charizard-mega-x"), the latter resolving to their species.
+func (r *Repository) GetSpeciesByName(ctx context.Context, name string) (*model.PokemonSpecies, error) {
+	species, err := r.getSpecies(ctx, "name = ?", name)
+	if !errors.Is(err, ErrPokemonNotFound) {
+		return species, err
+	}
+
+	orm := database.Orm(ctx)
+
+	var variety model.Pokemon
+	result := orm.WithContext(ctx).Where("name = ?", name).First(&variety)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			logger.FromContext(ctx).Debug("Pokemon not found", zap.String("name", name))
+			return nil, ErrPokemonNotFound
+		}
+		logger.FromContext(ctx).Error("Failed to find pokemon", zap.String("name", name), zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	return r.GetSpeciesByID(ctx, variety.SpeciesID)
+}
+
+func (r *Repository) getSpecies(ctx context.Context, query string, args ...interface{}) (*model.PokemonSpecies, error) {
+	orm := database.Orm(ctx)
+
+	var species model.PokemonSpecies
+	result := orm.WithContext(ctx).
+		Preload("Varieties", func(db *gorm.DB) *gorm.DB {
+			return db.Order("is_default DESC, sort_order, id")
+		}).
+		Preload("Varieties.Types", orderBySlot).
+		Preload("Varieties.Types.Type").
+		Preload("Varieties.Abilities", orderBySlot).
+		Preload("Varieties.Abilities.Ability").
+		Preload("Varieties.Stats").
+		Preload("Varieties.Forms", func(db *gorm.DB) *gorm.DB {
+			return db.Order("form_order, id")
+		}).
+		Where(query, args...).
+		First(&species)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			logger.FromContext(ctx).Debug("Pokemon species not found", zap.Any("query", args))
+			return nil, ErrPokemonNotFound
+		}
+		logger.FromContext(ctx).Error("Failed to find pokemon species", zap.Any("query", args), zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	return &species, nil
+}
+
+// GetEvolutionChain returns the chain with its baby trigger item.
+func (r *Repository) GetEvolutionChain(ctx context.Context, id int) (*model.EvolutionChain, error) {
+	orm := database.Orm(ctx)
+
+	var chain model.EvolutionChain
+	result := orm.WithContext(ctx).Preload("BabyTriggerItem").Where("id = ?", id).First(&chain)
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to get evolution chain", zap.Int("id", id), zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	return &chain, nil
+}
+
+// ChainSpecies returns every species of an evolution chain.
+func (r *Repository) ChainSpecies(ctx context.Context, chainID int) ([]model.PokemonSpecies, error) {
+	orm := database.Orm(ctx)
+
+	var species []model.PokemonSpecies
+	result := orm.WithContext(ctx).
+		Where("evolution_chain_id = ?", chainID).
+		Order("sort_order, id").
+		Find(&species)
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to list evolution chain species", zap.Int("chain_id", chainID), zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	return species, nil
+}
+
+// Evolutions returns the ways of evolving into the given species, with
+// every entity their conditions reference.
+func (r *Repository) Evolutions(ctx context.Context, speciesIDs []int) ([]model.PokemonEvolution, error) {
+	orm := database.Orm(ctx)
+
+	var evolutions []model.PokemonEvolution
+	result := orm.WithContext(ctx).
+		Preload("Trigger").
+		Preload("TriggerItem").
+		Preload("Location").
+		Preload("HeldItem").
+		Preload("KnownMove").
+		Preload("KnownMoveType").
+		Preload("PartySpecies").
+		Preload("PartyType").
+		Preload("TradeSpecies").
+		Where("evolved_species_id IN ?", speciesIDs).
+		Order("id").
+		Find(&evolutions)
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to list evolutions", zap.Ints("species_ids", speciesIDs), zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	return evolutions, nil
+}
+
+// GetVariety looks a variety up by id or by name. Species names resolve to
+// their default variety, e.g. deoxys to deoxys-normal.
+func (r *Repository) GetVariety(ctx context.Context, query string, args ...interface{}) (*model.Pokemon, error) {
+	orm := database.Orm(ctx)
+
+	var variety model.Pokemon
+	result := orm.WithContext(ctx).Where(query, args...).First(&variety)
+	if result.Error == nil {
+		return &variety, nil
+	}
+	if !errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		logger.FromContext(ctx).Error("Failed to find pokemon", zap.Any("query", args), zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	result = orm.WithContext(ctx).
+		Where("is_default AND species_id = (SELECT id FROM pokemon_species WHERE "+query+")", args...).
+		First(&variety)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrPokemonNotFound
+		}
+		logger.FromContext(ctx).Error("Failed to find default variety", zap.Any("query", args), zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	return &variety, nil
+}
+
+// GetVersionGroup accepts both version group names ("scarlet-violet") and
+// version names ("scarlet"), the latter resolving to their group.
+func (r *Repository) GetVersionGroup(ctx context.Context, name string) (*model.VersionGroup, error) {
+	orm := database.Orm(ctx)
+
+	var group model.VersionGroup
+	result := orm.WithContext(ctx).
+		Where("name = ? OR id = (SELECT version_group_id FROM versions WHERE name = ?)", name, name).
+		First(&group)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrVersionNotFound
+		}
+		logger.FromContext(ctx).Error("Failed to find version group", zap.String("name", name), zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	return &group, nil
+}
+
+// LatestVersionGroup returns the newest version group in which the variety
+// learns any move, or nil when it learns none.
+func (r *Repository) LatestVersionGroup(ctx context.Context, pokemonID int) (*model.VersionGroup, error) {
+	orm := database.Orm(ctx)
+
+	var groups []model.VersionGroup
+	result := orm.WithContext(ctx).
+		Where("id IN (SELECT version_group_id FROM pokemon_moves WHERE pokemon_id = ?)", pokemonID).
+		Order("sort_order DESC").
+		Limit(1).
+		Find(&groups)
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to find latest version group", zap.Int("pokemon_id", pokemonID), zap.Error(result.Error))
+		return nil, result.Error
+	}
+	if len(groups) == 0 {
+		return nil, nil
+	}
+
+	return &groups[0], nil
+}
+
+// Learnset returns the moves a variety learns in a version group, ordered
+// by learn method, level and the order of the games.
+func (r *Repository) Learnset(ctx context.Context, pokemonID, versionGroupID int) ([]model.PokemonMove, error) {
+	orm := database.Orm(ctx)
+
+	var moves []model.PokemonMove
+	result := orm.WithContext(ctx).
+		Preload("Move").
+		Preload("Move.Type").
+		Preload("LearnMethod").
+		Where("pokemon_id = ? AND version_group_id = ?", pokemonID, versionGroupID).
+		Order("learn_method_id, level, sort_order, move_id").
+		Find(&moves)
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to list learnset", zap.Int("pokemon_id", pokemonID), zap.Int("version_group_id", versionGroupID), zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	return moves, nil
+}
+
+// totalExpression must match the expression of idx_pokemon_base_stats_total
+// for the index to be used.
+const totalExpression = "(pokemon_base_stats.hp + pokemon_base_stats.attack + pokemon_base_stats.defense + " +
+	"pokemon_base_stats.special_attack + pokemon_base_stats.special_defense + pokemon_base_stats.speed)"
+
+// columns maps the numeric and boolean search fields to SQL. Only these
+// fixed expressions and the operators below are ever written into the
+// query; values are always bound as parameters.
+var columns = map[string]string{
+	search.FieldID:             "pokemon.id",
+	search.FieldName:           "pokemon.name",
+	search.FieldGeneration:     "pokemon_species.generation",
+	search.FieldHP:             "pokemon_base_stats.hp",
+	search.FieldAttack:         "pokemon_base_stats.attack",
+	search.FieldDefense:        "pokemon_base_stats.defense",
+	search.FieldSpecialAttack:  "pokemon_base_stats.special_attack",
+	search.FieldSpecialDefense: "pokemon_base_stats.special_defense",
+	search.FieldSpeed:          "pokemon_base_stats.speed",
+	search.FieldTotal:          totalExpression,
+	search.FieldLegendary:      "pokemon_species.is_legendary",
+	search.FieldMythical:       "pokemon_species.is_mythical",
+	search.FieldBaby:           "pokemon_species.is_baby",
+}
+
+// lookups are the subqueries of the string search fields, matching the
+// varieties linked to any of the given names.
+var lookups = map[string]string{
+	search.FieldType: "SELECT 1 FROM pokemon_types JOIN types ON types.id = pokemon_types.type_id " +
+		"WHERE pokemon_types.pokemon_id = pokemon.id AND types.name IN ?",
+	search.FieldAbility: "SELECT 1 FROM pokemon_abilities JOIN abilities ON abilities.id = pokemon_abilities.ability_id " +
+		"WHERE pokemon_abilities.pokemon_id = pokemon.id AND abilities.name IN ?",
+	search.FieldLearns: "SELECT 1 FROM pokemon_moves JOIN moves ON moves.id = pokemon_moves.move_id " +
+		"WHERE pokemon_moves.pokemon_id = pokemon.id AND moves.name IN ?",
+	search.FieldEggGroup: "SELECT 1 FROM pokemon_egg_groups JOIN egg_groups ON egg_groups.id = pokemon_egg_groups.egg_group_id " +
+		"WHERE pokemon_egg_groups.species_id = pokemon.species_id AND egg_groups.name IN ?",
+}
+
+var operators = map[string]string{
+	search.Equal:          "=",
+	search.NotEqual:       "<>",
+	search.Greater:        ">",
+	search.GreaterOrEqual: ">=",
+	search.Less:           "<",
+	search.LessOrEqual:    "<=",
+}
+
+// Search returns the varieties matching every filter of the query, with
+// their types, abilities and base stats. Alternate varieties are left out
+// unless the query has forms:true. Varieties without base stats never
+// match.
+func (r *Repository) Search(ctx context.Context, query *search.Query, sort search.Sort, offset, limit int) (pokemon []model.Pokemon, total int64, err error) {
+	orm := database.Orm(ctx)
+
+	db := orm.WithContext(ctx).
+		Model(&model.Pokemon{}).
+		Joins("JOIN pokemon_species ON pokemon_species.id = pokemon.species_id").
+		Joins("JOIN pokemon_base_stats ON pokemon_base_stats.pokemon_id = pokemon.id")
+
+	forms := false
+	for _, f := range query.Filters {
+		if f.Field == search.FieldForms {
+			forms = f.Bool == (f.Operator == search.Equal)
+			continue
+		}
+
+		if subquery, ok := lookups[f.Field]; ok {
+			if f.Operator == search.NotEqual {
+				db = db.Where("NOT EXISTS ("+subquery+")", f.Values)
+			} else {
+				db = db.Where("EXISTS ("+subquery+")", f.Values)
+			}
+			continue
+		}
+
+		column, ok := columns[f.Field]
+		operator, known := operators[f.Operator]
+		if !ok || !known {
+			return nil, 0, fmt.Errorf("unsupported filter %q", f.Term)
+		}
+		if f.Field == search.FieldLegendary || f.Field == search.FieldMythical || f.Field == search.FieldBaby {
+			db = db.Where(column+" "+operator+" ?", f.Bool)
+		} else {
+			db = db.Where(column+" "+operator+" ?", f.Number)
+		}
+	}
+	if !forms {
+		db = db.Where("pokemon.is_default")
+	}
+	db = db.Session(&gorm.Session{})
+
+	if err := db.Count(&total).Error; err != nil {
+		logger.FromContext(ctx).Error("Failed to count search results", zap.Error(err))
+		return nil, 0, err
+	}
+
+	order, ok := columns[sort.Field]
+	if !ok {
+		return nil, 0, fmt.Errorf("unsupported sort %q", sort.Field)
+	}
+	direction := "ASC"
+	if sort.Descending {
+		direction = "DESC"
+	}
+
+	result := db.
+		Preload("Types", orderBySlot).
+		Preload("Types.Type").
+		Preload("Abilities", orderBySlot).
+		Preload("Abilities.Ability").
+		Preload("Stats").
+		Select("pokemon.*").
+		Order(order + " " + direction + ", pokemon.id").
+		Offset(offset).
+		Limit(limit).
+		Find(&pokemon)
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to search pokemon", zap.Int("offset", offset), zap.Int("limit", limit), zap.Error(result.Error))
+		return nil, 0, result.Error
+	}
+
+	return pokemon, total, nil
+}
+
+// SpeciesTranslations returns the translations of the given species in the
+// given languages.
+func (r *Repository) SpeciesTranslations(ctx context.Context, ids []int, languages []string) ([]model.SpeciesTranslation, error) {
+	orm := database.Orm(ctx)
+
+	var translations []model.SpeciesTranslation
+	result := orm.WithContext(ctx).
+		Where("species_id IN ? AND language IN ?", ids, languages).
+		Find(&translations)
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to get species translations", zap.Strings("languages", languages), zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	return translations, nil
+}
+
+// MoveTranslations returns the translations of the given moves in the given
+// languages.
+func (r *Repository) MoveTranslations(ctx context.Context, ids []int, languages []string) ([]model.MoveTranslation, error) {
+	orm := database.Orm(ctx)
+
+	var translations []model.MoveTranslation
+	result := orm.WithContext(ctx).
+		Where("move_id IN ? AND language IN ?", ids, languages).
+		Find(&translations)
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to get move translations", zap.Strings("languages", languages), zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	return translations, nil
+}
+
+func orderBySlot(db *gorm.DB) *gorm.DB {
+	return db.Order("slot")
+}
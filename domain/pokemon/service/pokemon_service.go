@@ -0,0 +1,473 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"pokedex_backend_go/domain/pokemon/repository"
+	"pokedex_backend_go/pkg/dto"
+	"pokedex_backend_go/pkg/i18n"
+	"pokedex_backend_go/pkg/logger"
+	"pokedex_backend_go/pkg/model"
+	"pokedex_backend_go/pkg/problem"
+	"pokedex_backend_go/pkg/search"
+	"pokedex_backend_go/pkg/typechart"
+	"pokedex_backend_go/pkg/validation"
+
+	"go.uber.org/zap"
+)
+
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+var ErrInvalidPagination = problem.New(http.StatusBadRequest, "invalid_pagination", "page must be at least 1 and page_size between 1 and 100")
+
+func NewService(repo *repository.Repository) *Service {
+	return &Service{
+		repo: repo,
+	}
+}
+
+type Service struct {
+	repo *repository.Repository
+}
+
+func (s *Service) List(ctx context.Context, page, pageSize int) (*dto.PokemonListResponse, error) {
+	if page < 1 || pageSize < 1 || pageSize > MaxPageSize {
+		logger.FromContext(ctx).Debug("Invalid pagination", zap.Int("page", page), zap.Int("page_size", pageSize))
+		return nil, ErrInvalidPagination
+	}
+
+	species, total, err := s.repo.ListSpecies(ctx, (page-1)*pageSize, pageSize)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to list pokemon", zap.Int("page", page), zap.Error(err))
+		return nil, err
+	}
+
+	ids := make([]int, 0, len(species))
+	for _, sp := range species {
+		ids = append(ids, sp.ID)
+	}
+	translations, err := s.speciesTranslations(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dto.PokemonSummary, 0, len(species))
+	for _, sp := range species {
+		summary := summarize(sp)
+		summary.DisplayName = i18n.Pick(translations[sp.ID].Name, sp.Name)
+		items = append(items, summary)
+	}
+
+	return &dto.PokemonListResponse{
+		Items:    items,
+		Page:     page,
+		PageSize: pageSize,
+		Total:    total,
+	}, nil
+}
+
+// Get looks a species up by national dex number or by name.
+func (s *Service) Get(ctx context.Context, idOrName string) (*model.PokemonSpecies, error) {
+	idOrName = strings.ToLower(strings.TrimSpace(idOrName))
+	if idOrName == "" {
+		return nil, repository.ErrPokemonNotFound
+	}
+
+	if id, err := strconv.Atoi(idOrName); err == nil {
+		return s.repo.GetSpeciesByID(ctx, id)
+	}
+
+	return s.repo.GetSpeciesByName(ctx, idOrName)
+}
+
+// Detail returns a species with its name, genus and flavor text in the
+// language of the context.
+func (s *Service) Detail(ctx context.Context, idOrName string) (*dto.PokemonResponse, error) {
+	species, err := s.Get(ctx, idOrName)
+	if err != nil {
+		return nil, err
+	}
+
+	translations, err := s.speciesTranslations(ctx, []int{species.ID})
+	if err != nil {
+		return nil, err
+	}
+	translation := translations[species.ID]
+
+	return &dto.PokemonResponse{
+		Pokemon:     *species,
+		DisplayName: i18n.Pick(translation.Name, species.Name),
+		Genus:       translation.Genus,
+		FlavorText:  translation.FlavorText,
+	}, nil
+}
+
+// Evolutions returns the whole evolution chain of a species, including the
+// branches that don't lead to it.
+func (s *Service) Evolutions(ctx context.Context, idOrName string) (*dto.EvolutionChainResponse, error) {
+	species, err := s.Get(ctx, idOrName)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.EvolutionChainResponse{ID: species.EvolutionChainID}
+
+	members := []model.PokemonSpecies{*species}
+	if species.EvolutionChainID != nil {
+		chain, err := s.repo.GetEvolutionChain(ctx, *species.EvolutionChainID)
+		if err != nil {
+			return nil, err
+		}
+		if chain.BabyTriggerItem != nil {
+			response.BabyTriggerItem = &chain.BabyTriggerItem.Name
+		}
+
+		members, err = s.repo.ChainSpecies(ctx, chain.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ids := make([]int, 0, len(members))
+	inChain := make(map[int]bool, len(members))
+	for _, m := range members {
+		ids = append(ids, m.ID)
+		inChain[m.ID] = true
+	}
+
+	evolutions, err := s.repo.Evolutions(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	translations, err := s.speciesTranslations(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	conditions := make(map[int][]dto.EvolutionCondition, len(members))
+	for _, e := range evolutions {
+		conditions[e.EvolvedSpeciesID] = append(conditions[e.EvolvedSpeciesID], condition(e))
+	}
+
+	// members are sorted, so children keep the dex order and the first root
+	// found is the first stage.
+	children := make(map[int][]model.PokemonSpecies, len(members))
+	var root *model.PokemonSpecies
+	for i, m := range members {
+		if m.EvolvesFromSpeciesID != nil && inChain[*m.EvolvesFromSpeciesID] {
+			children[*m.EvolvesFromSpeciesID] = append(children[*m.EvolvesFromSpeciesID], m)
+		} else if root == nil {
+			root = &members[i]
+		}
+	}
+	if root == nil {
+		return nil, fmt.Errorf("evolution chain %d has no first stage", *species.EvolutionChainID)
+	}
+
+	var build func(m model.PokemonSpecies) dto.EvolutionNode
+	build = func(m model.PokemonSpecies) dto.EvolutionNode {
+		node := dto.EvolutionNode{
+			ID:          m.ID,
+			Name:        m.Name,
+			DisplayName: i18n.Pick(translations[m.ID].Name, m.Name),
+			IsBaby:      m.IsBaby,
+			Conditions:  []dto.EvolutionCondition{},
+			EvolvesTo:   []dto.EvolutionNode{},
+		}
+		if m.ID != root.ID {
+			node.Conditions = append(node.Conditions, conditions[m.ID]...)
+		}
+		for _, child := range children[m.ID] {
+			node.EvolvesTo = append(node.EvolvesTo, build(child))
+		}
+		return node
+	}
+	response.Chain = build(*root)
+
+	return response, nil
+}
+
+// Moves returns the learnset of a variety in a version group or version.
+// Without a version, the newest version group the variety appears in is
+// used.
+func (s *Service) Moves(ctx context.Context, idOrName, version string) (*dto.PokemonMovesResponse, error) {
+	idOrName = strings.ToLower(strings.TrimSpace(idOrName))
+	if idOrName == "" {
+		return nil, repository.ErrPokemonNotFound
+	}
+
+	var pokemon *model.Pokemon
+	var err error
+	if id, convErr := strconv.Atoi(idOrName); convErr == nil {
+		pokemon, err = s.repo.GetVariety(ctx, "id = ?", id)
+	} else {
+		pokemon, err = s.repo.GetVariety(ctx, "name = ?", idOrName)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.PokemonMovesResponse{Pokemon: pokemon.Name, Moves: []dto.LearnedMove{}}
+
+	var group *model.VersionGroup
+	if version = strings.ToLower(strings.TrimSpace(version)); version != "" {
+		group, err = s.repo.GetVersionGroup(ctx, version)
+	} else {
+		group, err = s.repo.LatestVersionGroup(ctx, pokemon.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if group == nil {
+		return response, nil
+	}
+	response.VersionGroup = group.Name
+
+	learnset, err := s.repo.Learnset(ctx, pokemon.ID, group.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, 0, len(learnset))
+	for _, l := range learnset {
+		ids = append(ids, l.MoveID)
+	}
+	translations, err := s.moveTranslations(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, l := range learnset {
+		learned := dto.LearnedMove{Level: l.Level}
+		if l.Move != nil {
+			learned.Move = l.Move.Name
+			learned.DisplayName = i18n.Pick(translations[l.MoveID].Name, l.Move.Name)
+			learned.DamageClass = l.Move.DamageClass
+			learned.Power = l.Move.Power
+			learned.Accuracy = l.Move.Accuracy
+			learned.PP = l.Move.PP
+			if l.Move.Type != nil {
+				learned.Type = l.Move.Type.Name
+			}
+		}
+		if l.LearnMethod != nil {
+			learned.Method = l.LearnMethod.Name
+		}
+		response.Moves = append(response.Moves, learned)
+	}
+
+	return response, nil
+}
+
+func condition(e model.PokemonEvolution) dto.EvolutionCondition {
+	c := dto.EvolutionCondition{
+		MinimumLevel:          e.MinimumLevel,
+		TimeOfDay:             e.TimeOfDay,
+		Gender:                e.Gender,
+		MinimumHappiness:      e.MinimumHappiness,
+		MinimumBeauty:         e.MinimumBeauty,
+		MinimumAffection:      e.MinimumAffection,
+		RelativePhysicalStats: e.RelativePhysicalStats,
+		NeedsOverworldRain:    e.NeedsOverworldRain,
+		TurnUpsideDown:        e.TurnUpsideDown,
+	}
+	if e.Trigger != nil {
+		c.Trigger = e.Trigger.Name
+	}
+	if e.TriggerItem != nil {
+		c.Item = &e.TriggerItem.Name
+	}
+	if e.HeldItem != nil {
+		c.HeldItem = &e.HeldItem.Name
+	}
+	if e.KnownMove != nil {
+		c.KnownMove = &e.KnownMove.Name
+	}
+	if e.KnownMoveType != nil {
+		c.KnownMoveType = &e.KnownMoveType.Name
+	}
+	if e.Location != nil {
+		c.Location = &e.Location.Name
+	}
+	if e.PartySpecies != nil {
+		c.PartySpecies = &e.PartySpecies.Name
+	}
+	if e.PartyType != nil {
+		c.PartyType = &e.PartyType.Name
+	}
+	if e.TradeSpecies != nil {
+		c.TradeSpecies = &e.TradeSpecies.Name
+	}
+
+	return c
+}
+
+// Search returns the varieties matching a query of the search package.
+// Syntax errors and unknown types are returned as validation.Errors.
+func (s *Service) Search(ctx context.Context, q, sortBy string, page, pageSize int) (*dto.PokemonSearchResponse, error) {
+	if page < 1 || pageSize < 1 || pageSize > MaxPageSize {
+		return nil, ErrInvalidPagination
+	}
+
+	var errs validation.Errors
+
+	query, err := search.Parse(q)
+	var syntax search.Errors
+	switch {
+	case errors.As(err, &syntax):
+		// The term is prepended to the format of the parser, so the catalog
+		// translates e.g. "%s: unknown field %q".
+		for _, e := range syntax {
+			errs.Add("q", "invalid_filter", "%s: "+e.Format, append([]any{e.Term}, e.Args...)...)
+		}
+	case err != nil:
+		return nil, err
+	default:
+		chart := typechart.Latest()
+		for _, f := range query.Filters {
+			if f.Field != search.FieldType {
+				continue
+			}
+			for _, t := range f.Values {
+				if !chart.Has(t) {
+					errs.Add("q", "unknown_type", "%s: unknown type %q", f.Term, t)
+				}
+			}
+		}
+	}
+
+	sort, err := search.ParseSort(sortBy)
+	if errors.As(err, &syntax) {
+		for _, e := range syntax {
+			errs.Add("sort", "invalid_sort", "%s: "+e.Format, append([]any{e.Term}, e.Args...)...)
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err := errs.Err(); err != nil {
+		return nil, err
+	}
+
+	pokemon, total, err := s.repo.Search(ctx, query, sort, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, 0, len(pokemon))
+	for _, p := range pokemon {
+		ids = append(ids, p.SpeciesID)
+	}
+	translations, err := s.speciesTranslations(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dto.PokemonSearchResult, 0, len(pokemon))
+	for _, p := range pokemon {
+		result := dto.PokemonSearchResult{
+			ID:          p.ID,
+			Name:        p.Name,
+			DisplayName: i18n.Pick(translations[p.SpeciesID].Name, p.Name),
+			SpeciesID:   p.SpeciesID,
+			IsDefault:   p.IsDefault,
+			Types:       []string{},
+			Abilities:   []string{},
+		}
+		for _, t := range p.Types {
+			result.Types = append(result.Types, t.Type.Name)
+		}
+		for _, a := range p.Abilities {
+			result.Abilities = append(result.Abilities, a.Ability.Name)
+		}
+		if p.Stats != nil {
+			result.Stats = p.Stats.Spread()
+			result.Total = result.Stats.Total()
+		}
+		items = append(items, result)
+	}
+
+	return &dto.PokemonSearchResponse{
+		Items:    items,
+		Page:     page,
+		PageSize: pageSize,
+		Total:    total,
+	}, nil
+}
+
+// speciesTranslations returns the translation of each species in the
+// language of the context, every field falling back to the default
+// language when it is missing.
+func (s *Service) speciesTranslations(ctx context.Context, ids []int) (map[int]model.SpeciesTranslation, error) {
+	rows, err := s.repo.SpeciesTranslations(ctx, ids, i18n.Languages(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	requested := i18n.Code(i18n.FromContext(ctx))
+	merged := make(map[int]model.SpeciesTranslation, len(ids))
+	for _, preferred := range []bool{true, false} {
+		for _, row := range rows {
+			if (row.Language == requested) != preferred {
+				continue
+			}
+			m := merged[row.SpeciesID]
+			m.Name = i18n.Pick(m.Name, row.Name)
+			m.Genus = i18n.Pick(m.Genus, row.Genus)
+			m.FlavorText = i18n.Pick(m.FlavorText, row.FlavorText)
+			merged[row.SpeciesID] = m
+		}
+	}
+
+	return merged, nil
+}
+
+// moveTranslations is speciesTranslations for moves.
+func (s *Service) moveTranslations(ctx context.Context, ids []int) (map[int]model.MoveTranslation, error) {
+	rows, err := s.repo.MoveTranslations(ctx, ids, i18n.Languages(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	requested := i18n.Code(i18n.FromContext(ctx))
+	merged := make(map[int]model.MoveTranslation, len(ids))
+	for _, preferred := range []bool{true, false} {
+		for _, row := range rows {
+			if (row.Language == requested) != preferred {
+				continue
+			}
+			m := merged[row.MoveID]
+			m.Name = i18n.Pick(m.Name, row.Name)
+			m.FlavorText = i18n.Pick(m.FlavorText, row.FlavorText)
+			merged[row.MoveID] = m
+		}
+	}
+
+	return merged, nil
+}
+
+func summarize(species model.PokemonSpecies) dto.PokemonSummary {
+	summary := dto.PokemonSummary{
+		ID:         species.ID,
+		Name:       species.Name,
+		Generation: species.Generation,
+		Types:      []string{},
+	}
+
+	if len(species.Varieties) > 0 {
+		for _, t := range species.Varieties[0].Types {
+			summary.Types = append(summary.Types, t.Type.Name)
+		}
+	}
+
+	return summary
+}
@@ -6,7 +6,10 @@ import (
 
 	"pokedex_backend_go/domain/profile/service"
 	"pokedex_backend_go/pkg/auth"
+	"pokedex_backend_go/pkg/logger"
 	"pokedex_backend_go/pkg/model"
+	"pokedex_backend_go/pkg/problem"
+	"pokedex_backend_go/pkg/request"
 
 	"github.com/go-chi/chi/v5"
 	"go.uber.org/zap"
@@ -14,13 +17,11 @@ import (
 
 type ProfileHandler struct {
 	service *service.Service
-	logger  *zap.Logger
 }
 
 func NewHandler(service *service.Service) *ProfileHandler {
 	return &ProfileHandler{
 		service: service,
-		logger:  zap.L().Named("profile_handler"),
 	}
 }
 
@@ -44,23 +45,18 @@ type ProfileResponse struct {
 func (handler *ProfileHandler) GetProfile(w http.ResponseWriter, r *http.Request) {
 	claims, ok := auth.GetUserFromContext(r.Context())
 	if !ok {
-		handler.logger.Error("Failed to get user from context")
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		logger.FromContext(r.Context()).Error("Failed to get user from context")
+		problem.Write(w, r, problem.ErrInternal)
 		return
 	}
 
 	ctx := r.Context()
 	user, err := handler.service.GetProfile(ctx, claims.UserID)
 	if err != nil {
-		switch {
-		case err.Error() == "user not found":
-			http.Error(w, "User not found", http.StatusNotFound)
-		case err.Error() == "user ID is required":
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		default:
-			handler.logger.Error("Failed to get user profile", zap.Error(err))
-			http.Error(w, "Internal server error", http.StatusInternalServerError)
+		if problem.Internal(err) {
+			logger.FromContext(ctx).Error("Failed to get user profile", zap.Error(err))
 		}
+		problem.Write(w, r, err)
 		return
 	}
 
@@ -72,35 +68,33 @@ func (handler *ProfileHandler) GetProfile(w http.ResponseWriter, r *http.Request
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(response); err != nil {
-		handler.logger.Error("Failed to encode profile response", zap.Error(err))
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		logger.FromContext(ctx).Error("Failed to encode profile response", zap.Error(err))
+		problem.Write(w, r, problem.ErrInternal)
 		return
 	}
 
-	handler.logger.Info("Profile retrieved successfully", zap.String("user_id", claims.UserID))
+	logger.FromContext(ctx).Info("Profile retrieved successfully", zap.String("user_id", claims.UserID))
 }
 
 type UpdateProfilePayload struct {
-	Name     *string `json:"name"`
-	Phone    *string `json:"phone"`
-	Username *string `json:"username"`
+	Name     *string `json:"name" validate:"max=255"`
+	Phone    *string `json:"phone" validate:"max=255"`
+	Username *string `json:"username" validate:"max=255"`
 }
 
 func (handler *ProfileHandler) UpdateProfile(w http.ResponseWriter, r *http.Request) {
 	claims, ok := auth.GetUserFromContext(r.Context())
 	if !ok {
-		handler.logger.Error("Failed to get user from context")
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		logger.FromContext(r.Context()).Error("Failed to get user from context")
+		problem.Write(w, r, problem.ErrInternal)
 		return
 	}
 
 	var req UpdateProfilePayload
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "Invalid JSON format", http.StatusBadRequest)
-		handler.logger.Error("Failed to decode request", zap.Error(err))
+	if err := request.Decode(w, r, &req); err != nil {
+		problem.Write(w, r, err)
 		return
 	}
-	defer r.Body.Close()
 
 	updates := make(map[string]interface{})
 	if req.Name != nil {
@@ -116,17 +110,10 @@ func (handler *ProfileHandler) UpdateProfile(w http.ResponseWriter, r *http.Requ
 	ctx := r.Context()
 	user, err := handler.service.UpdateProfile(ctx, claims.UserID, updates)
 	if err != nil {
-		switch {
-		case err.Error() == "user not found":
-			http.Error(w, "User not found", http.StatusNotFound)
-		case err.Error() == "username already exists":
-			http.Error(w, "Username already exists", http.StatusConflict)
-		case err.Error() == "user ID is required" || err.Error() == "no updates provided" || err.Error() == "no valid updates provided":
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		default:
-			handler.logger.Error("Failed to update user profile", zap.Error(err))
-			http.Error(w, "Internal server error", http.StatusInternalServerError)
+		if problem.Internal(err) {
+			logger.FromContext(ctx).Error("Failed to update user profile", zap.Error(err))
 		}
+		problem.Write(w, r, err)
 		return
 	}
 
@@ -138,10 +125,10 @@ func (handler *ProfileHandler) UpdateProfile(w http.ResponseWriter, r *http.Requ
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(response); err != nil {
-		handler.logger.Error("Failed to encode profile response", zap.Error(err))
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		logger.FromContext(ctx).Error("Failed to encode profile response", zap.Error(err))
+		problem.Write(w, r, problem.ErrInternal)
 		return
 	}
 
-	handler.logger.Info("Profile updated successfully", zap.String("user_id", claims.UserID))
+	logger.FromContext(ctx).Info("Profile updated successfully", zap.String("user_id", claims.UserID))
 }
@@ -3,24 +3,27 @@ package repository
 import (
 	"context"
 	"errors"
+	"net/http"
 
 	"pokedex_backend_go/pkg/database"
+	"pokedex_backend_go/pkg/logger"
 	"pokedex_backend_go/pkg/model"
+	"pokedex_backend_go/pkg/problem"
 
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 )
 
-var ErrUserNotFound = errors.New("user not found")
+var (
+	ErrUserNotFound  = problem.New(http.StatusNotFound, "user_not_found", "User not found")
+	ErrUsernameTaken = problem.New(http.StatusConflict, "username_taken", "Username already exists")
+)
 
 func NewRepository() *Repository {
-	return &Repository{
-		logger: zap.L().Named("profile_repository"),
-	}
+	return &Repository{}
 }
 
 type Repository struct {
-	logger *zap.Logger
 }
 
 func (r *Repository) GetUserByID(ctx context.Context, userID string) (user *model.User, err error) {
@@ -30,14 +33,14 @@ func (r *Repository) GetUserByID(ctx context.Context, userID string) (user *mode
 	result := orm.WithContext(ctx).Where("id = ?", userID).First(&foundUser)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
-			r.logger.Error("User not found", zap.String("user_id", userID))
+			logger.FromContext(ctx).Error("User not found", zap.String("user_id", userID))
 			return nil, ErrUserNotFound
 		}
-		r.logger.Error("Failed to find user", zap.String("user_id", userID), zap.Error(result.Error))
+		logger.FromContext(ctx).Error("Failed to find user", zap.String("user_id", userID), zap.Error(result.Error))
 		return nil, result.Error
 	}
 
-	r.logger.Info("User found successfully", zap.String("user_id", userID), zap.String("email", foundUser.Email))
+	logger.FromContext(ctx).Info("User found successfully", zap.String("user_id", userID), zap.String("email", foundUser.Email))
 
 	foundUser.Password = ""
 	return &foundUser, nil
@@ -50,10 +53,10 @@ func (r *Repository) UpdateUser(ctx context.Context, userID string, updates map[
 	result := orm.WithContext(ctx).Where("id = ?", userID).First(&foundUser)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
-			r.logger.Error("User not found for update", zap.String("user_id", userID))
+			logger.FromContext(ctx).Error("User not found for update", zap.String("user_id", userID))
 			return nil, ErrUserNotFound
 		}
-		r.logger.Error("Failed to find user for update", zap.String("user_id", userID), zap.Error(result.Error))
+		logger.FromContext(ctx).Error("Failed to find user for update", zap.String("user_id", userID), zap.Error(result.Error))
 		return nil, result.Error
 	}
 
@@ -62,10 +65,10 @@ func (r *Repository) UpdateUser(ctx context.Context, userID string, updates map[
 			var existingUser model.User
 			result := orm.WithContext(ctx).Where("username = ? AND id != ?", usernameStr, userID).First(&existingUser)
 			if result.Error == nil {
-				r.logger.Error("Username already exists", zap.String("username", usernameStr))
-				return nil, errors.New("username already exists")
+				logger.FromContext(ctx).Error("Username already exists", zap.String("username", usernameStr))
+				return nil, ErrUsernameTaken
 			} else if !errors.Is(result.Error, gorm.ErrRecordNotFound) {
-				r.logger.Error("Failed to check existing username", zap.Error(result.Error))
+				logger.FromContext(ctx).Error("Failed to check existing username", zap.Error(result.Error))
 				return nil, result.Error
 			}
 		}
@@ -73,11 +76,11 @@ func (r *Repository) UpdateUser(ctx context.Context, userID string, updates map[
 
 	result = orm.WithContext(ctx).Model(&foundUser).Updates(updates)
 	if result.Error != nil {
-		r.logger.Error("Failed to update user", zap.String("user_id", userID), zap.Error(result.Error))
+		logger.FromContext(ctx).Error("Failed to update user", zap.String("user_id", userID), zap.Error(result.Error))
 		return nil, result.Error
 	}
 
-	r.logger.Info("User updated successfully", zap.String("user_id", userID), zap.Any("updates", updates))
+	logger.FromContext(ctx).Info("User updated successfully", zap.String("user_id", userID), zap.Any("updates", updates))
 
 	foundUser.Password = ""
 	return &foundUser, nil
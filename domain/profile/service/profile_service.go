@@ -2,52 +2,59 @@ package service
 
 import (
 	"context"
-	"errors"
+	"net/http"
 	"strings"
 
 	"pokedex_backend_go/domain/profile/repository"
+	"pokedex_backend_go/pkg/i18n"
+	"pokedex_backend_go/pkg/logger"
 	"pokedex_backend_go/pkg/model"
+	"pokedex_backend_go/pkg/problem"
 
 	"go.uber.org/zap"
 )
 
+var (
+	ErrUserIDRequired  = problem.New(http.StatusBadRequest, "user_id_required", "user ID is required")
+	ErrNoUpdates       = problem.New(http.StatusBadRequest, "no_updates", "no valid updates provided")
+	ErrFieldNotAllowed = problem.New(http.StatusBadRequest, "field_not_allowed", "field is not allowed for update")
+)
+
 func NewService(repo *repository.Repository) *Service {
 	return &Service{
-		logger: zap.L().Named("profileService"),
-		repo:   repo,
+		repo: repo,
 	}
 }
 
 type Service struct {
-	logger *zap.Logger
-	repo   *repository.Repository
+	repo *repository.Repository
 }
 
 func (s *Service) GetProfile(ctx context.Context, userID string) (user *model.User, err error) {
 	if userID == "" {
-		s.logger.Error("User ID is required")
-		return nil, errors.New("user ID is required")
+		logger.FromContext(ctx).Error("User ID is required")
+		return nil, ErrUserIDRequired
 	}
 
 	user, err = s.repo.GetUserByID(ctx, userID)
 	if err != nil {
-		s.logger.Error("Failed to get user profile", zap.String("user_id", userID), zap.Error(err))
+		logger.FromContext(ctx).Error("Failed to get user profile", zap.String("user_id", userID), zap.Error(err))
 		return nil, err
 	}
 
-	s.logger.Info("User profile retrieved successfully", zap.String("user_id", userID))
+	logger.FromContext(ctx).Info("User profile retrieved successfully", zap.String("user_id", userID))
 	return user, nil
 }
 
 func (s *Service) UpdateProfile(ctx context.Context, userID string, updates map[string]interface{}) (user *model.User, err error) {
 	if userID == "" {
-		s.logger.Error("User ID is required")
-		return nil, errors.New("user ID is required")
+		logger.FromContext(ctx).Error("User ID is required")
+		return nil, ErrUserIDRequired
 	}
 
 	if len(updates) == 0 {
-		s.logger.Error("No updates provided")
-		return nil, errors.New("no updates provided")
+		logger.FromContext(ctx).Error("No updates provided")
+		return nil, i18n.Errorf(ErrNoUpdates, "no updates provided")
 	}
 
 	allowedFields := map[string]bool{
@@ -59,8 +66,8 @@ func (s *Service) UpdateProfile(ctx context.Context, userID string, updates map[
 	validUpdates := make(map[string]interface{})
 	for field, value := range updates {
 		if !allowedFields[field] {
-			s.logger.Error("Field not allowed for update", zap.String("field", field))
-			return nil, errors.New("field '" + field + "' is not allowed for update")
+			logger.FromContext(ctx).Error("Field not allowed for update", zap.String("field", field))
+			return nil, i18n.Errorf(ErrFieldNotAllowed, "field %q is not allowed for update", field)
 		}
 
 		if strValue, ok := value.(string); ok {
@@ -74,16 +81,16 @@ func (s *Service) UpdateProfile(ctx context.Context, userID string, updates map[
 	}
 
 	if len(validUpdates) == 0 {
-		s.logger.Error("No valid updates provided")
-		return nil, errors.New("no valid updates provided")
+		logger.FromContext(ctx).Error("No valid updates provided")
+		return nil, ErrNoUpdates
 	}
 
 	user, err = s.repo.UpdateUser(ctx, userID, validUpdates)
 	if err != nil {
-		s.logger.Error("Failed to update user profile", zap.String("user_id", userID), zap.Error(err))
+		logger.FromContext(ctx).Error("Failed to update user profile", zap.String("user_id", userID), zap.Error(err))
 		return nil, err
 	}
 
-	s.logger.Info("User profile updated successfully", zap.String("user_id", userID), zap.Any("updates", validUpdates))
+	logger.FromContext(ctx).Info("User profile updated successfully", zap.String("user_id", userID), zap.Any("updates", validUpdates))
 	return user, nil
 }
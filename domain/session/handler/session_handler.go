@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"pokedex_backend_go/domain/session/service"
+	"pokedex_backend_go/pkg/auth"
+	"pokedex_backend_go/pkg/logger"
+	"pokedex_backend_go/pkg/problem"
+	"pokedex_backend_go/pkg/request"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+type SessionHandler struct {
+	service *service.Service
+}
+
+func NewHandler(service *service.Service) *SessionHandler {
+	return &SessionHandler{
+		service: service,
+	}
+}
+
+func Handler(service *service.Service, authMiddleware *auth.AuthMiddleware) func(chi.Router) {
+	return func(r chi.Router) {
+		logger := zap.L().Named("session_handler_registration")
+		logger.Info("Registering session handler at /api/v1/auth")
+
+		handler := NewHandler(service)
+
+		r.Get("/.well-known/jwks.json", handler.JWKSRequest)
+		r.Post("/api/v1/auth/refresh", handler.RefreshRequest)
+		r.With(authMiddleware.RequireAuth).Post("/api/v1/auth/logout", handler.LogoutRequest)
+		r.With(authMiddleware.RequireAuth).Post("/api/v1/auth/logout/all", handler.LogoutAllRequest)
+	}
+}
+
+type RefreshPayload struct {
+	RefreshToken string `json:"refresh_token" validate:"required"`
+}
+
+func (handler *SessionHandler) RefreshRequest(w http.ResponseWriter, r *http.Request) {
+	var req RefreshPayload
+	if err := request.Decode(w, r, &req); err != nil {
+		problem.Write(w, r, err)
+		return
+	}
+
+	ctx := r.Context()
+	response, err := handler.service.Refresh(ctx, req.RefreshToken)
+	if err != nil {
+		if problem.Internal(err) {
+			logger.FromContext(ctx).Error("Failed to refresh token", zap.Error(err))
+		}
+		problem.Write(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.FromContext(ctx).Error("Failed to encode refresh response", zap.Error(err))
+	}
+}
+
+type LogoutPayload struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+func (handler *SessionHandler) LogoutRequest(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth.GetUserFromContext(r.Context())
+	if !ok {
+		logger.FromContext(r.Context()).Error("Failed to get user from context")
+		problem.Write(w, r, problem.ErrInternal)
+		return
+	}
+
+	// The body is optional: without a refresh token only the access token is revoked.
+	var req LogoutPayload
+	if err := request.DecodeOptional(w, r, &req); err != nil {
+		problem.Write(w, r, err)
+		return
+	}
+
+	ctx := r.Context()
+	if err := handler.service.Logout(ctx, claims, req.RefreshToken); err != nil {
+		if problem.Internal(err) {
+			logger.FromContext(ctx).Error("Failed to logout user", zap.Error(err))
+		}
+		problem.Write(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (handler *SessionHandler) LogoutAllRequest(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth.GetUserFromContext(r.Context())
+	if !ok {
+		logger.FromContext(r.Context()).Error("Failed to get user from context")
+		problem.Write(w, r, problem.ErrInternal)
+		return
+	}
+
+	ctx := r.Context()
+	if err := handler.service.LogoutAll(ctx, claims); err != nil {
+		logger.FromContext(ctx).Error("Failed to logout user from all devices", zap.Error(err))
+		problem.Write(w, r, problem.ErrInternal)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (handler *SessionHandler) JWKSRequest(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(handler.service.JWKS()); err != nil {
+		logger.FromContext(r.Context()).Error("Failed to encode JWKS response", zap.Error(err))
+	}
+}
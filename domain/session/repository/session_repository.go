@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"pokedex_backend_go/pkg/database"
+	"pokedex_backend_go/pkg/logger"
+	"pokedex_backend_go/pkg/model"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrRefreshTokenNotFound = errors.New("refresh token not found")
+	ErrUserNotFound         = errors.New("user not found")
+)
+
+func NewRepository() *Repository {
+	return &Repository{}
+}
+
+type Repository struct {
+}
+
+func (r *Repository) CreateRefreshToken(ctx context.Context, token *model.RefreshToken) error {
+	orm := database.Orm(ctx)
+
+	result := orm.WithContext(ctx).Create(token)
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to create refresh token", zap.String("user_id", token.UserID), zap.Error(result.Error))
+		return result.Error
+	}
+
+	logger.FromContext(ctx).Debug("Refresh token created", zap.String("user_id", token.UserID), zap.String("family_id", token.FamilyID))
+	return nil
+}
+
+// FindRefreshTokenByHash locks the row so concurrent refreshes of the same
+// token are serialized and only one of them can rotate it.
+func (r *Repository) FindRefreshTokenByHash(ctx context.Context, tokenHash string) (*model.RefreshToken, error) {
+	orm := database.Orm(ctx)
+
+	var token model.RefreshToken
+	result := orm.WithContext(ctx).Clauses(database.WithUpdate).Where("token_hash = ?", tokenHash).First(&token)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			logger.FromContext(ctx).Warn("Refresh token not found")
+			return nil, ErrRefreshTokenNotFound
+		}
+		logger.FromContext(ctx).Error("Failed to find refresh token", zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	return &token, nil
+}
+
+func (r *Repository) MarkRefreshTokenReplaced(ctx context.Context, tokenID, replacedBy string) error {
+	orm := database.Orm(ctx)
+
+	result := orm.WithContext(ctx).Model(&model.RefreshToken{}).
+		Where("id = ?", tokenID).
+		Updates(map[string]interface{}{
+			"revoked_at":  time.Now(),
+			"replaced_by": replacedBy,
+		})
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to rotate refresh token", zap.String("token_id", tokenID), zap.Error(result.Error))
+		return result.Error
+	}
+
+	return nil
+}
+
+func (r *Repository) RevokeRefreshTokenFamily(ctx context.Context, familyID string) error {
+	orm := database.Orm(ctx)
+
+	result := orm.WithContext(ctx).Model(&model.RefreshToken{}).
+		Where("family_id = ? AND revoked_at IS NULL", familyID).
+		Update("revoked_at", time.Now())
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to revoke refresh token family", zap.String("family_id", familyID), zap.Error(result.Error))
+		return result.Error
+	}
+
+	logger.FromContext(ctx).Info("Refresh token family revoked", zap.String("family_id", familyID), zap.Int64("revoked", result.RowsAffected))
+	return nil
+}
+
+func (r *Repository) RevokeUserRefreshTokens(ctx context.Context, userID string) error {
+	orm := database.Orm(ctx)
+
+	result := orm.WithContext(ctx).Model(&model.RefreshToken{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", time.Now())
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to revoke user refresh tokens", zap.String("user_id", userID), zap.Error(result.Error))
+		return result.Error
+	}
+
+	logger.FromContext(ctx).Info("User refresh tokens revoked", zap.String("user_id", userID), zap.Int64("revoked", result.RowsAffected))
+	return nil
+}
+
+func (r *Repository) GetUserByID(ctx context.Context, userID string) (*model.User, error) {
+	orm := database.Orm(ctx)
+
+	var foundUser model.User
+	result := orm.WithContext(ctx).Where("id = ?", userID).First(&foundUser)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			logger.FromContext(ctx).Error("User not found", zap.String("user_id", userID))
+			return nil, ErrUserNotFound
+		}
+		logger.FromContext(ctx).Error("Failed to find user", zap.String("user_id", userID), zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	foundUser.Password = ""
+	return &foundUser, nil
+}
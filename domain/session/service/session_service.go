@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"time"
+
+	"pokedex_backend_go/domain/session/repository"
+	"pokedex_backend_go/pkg/auth"
+	"pokedex_backend_go/pkg/database"
+	"pokedex_backend_go/pkg/dto"
+	"pokedex_backend_go/pkg/logger"
+	"pokedex_backend_go/pkg/model"
+	"pokedex_backend_go/pkg/problem"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const refreshTokenTTL = 30 * 24 * time.Hour
+
+var (
+	ErrInvalidRefreshToken = problem.New(http.StatusUnauthorized, "invalid_refresh_token", "Invalid refresh token")
+	// ErrRefreshTokenReused reads like ErrInvalidRefreshToken so clients
+	// cannot tell a leaked token apart.
+	ErrRefreshTokenReused = problem.New(http.StatusUnauthorized, "invalid_refresh_token", "Invalid refresh token")
+	// ErrForeignRefreshToken is a refresh token of another user sent on
+	// logout, by an authenticated client.
+	ErrForeignRefreshToken = problem.New(http.StatusBadRequest, "invalid_refresh_token", "Invalid refresh token")
+)
+
+func NewService(repo *repository.Repository, jwtService *auth.JWTService, revocations *auth.RevocationList) *Service {
+	return &Service{
+		repo:        repo,
+		jwtService:  jwtService,
+		revocations: revocations,
+	}
+}
+
+type Service struct {
+	repo        *repository.Repository
+	jwtService  *auth.JWTService
+	revocations *auth.RevocationList
+}
+
+// IssueTokens starts a new refresh token family for the user, typically right
+// after login or registration.
+func (s *Service) IssueTokens(ctx context.Context, user *model.User) (*dto.TokenResponse, error) {
+	refreshToken, err := s.createRefreshToken(ctx, user.ID, uuid.NewString())
+	if err != nil {
+		return nil, err
+	}
+
+	return s.tokenResponse(ctx, user, refreshToken)
+}
+
+// Refresh exchanges a refresh token for a new access token and a new refresh
+// token. Every refresh token can be used once; presenting an already rotated
+// token revokes its whole family, since it means the token was leaked.
+func (s *Service) Refresh(ctx context.Context, refreshToken string) (*dto.TokenResponse, error) {
+	var (
+		user      *model.User
+		nextToken string
+		reused    bool
+	)
+
+	err := database.Transactional(ctx, func(ctx context.Context) error {
+		stored, err := s.repo.FindRefreshTokenByHash(ctx, hashToken(refreshToken))
+		if err != nil {
+			if errors.Is(err, repository.ErrRefreshTokenNotFound) {
+				logger.FromContext(ctx).Debug("Unknown refresh token")
+				return ErrInvalidRefreshToken
+			}
+			return err
+		}
+
+		if stored.RevokedAt != nil {
+			logger.FromContext(ctx).Warn("Refresh token reuse detected, revoking family",
+				zap.String("user_id", stored.UserID), zap.String("family_id", stored.FamilyID))
+			reused = true
+			return s.repo.RevokeRefreshTokenFamily(ctx, stored.FamilyID)
+		}
+
+		if time.Now().After(stored.ExpiresAt) {
+			logger.FromContext(ctx).Warn("Refresh token expired", zap.String("user_id", stored.UserID))
+			return ErrInvalidRefreshToken
+		}
+
+		user, err = s.repo.GetUserByID(ctx, stored.UserID)
+		if err != nil {
+			if errors.Is(err, repository.ErrUserNotFound) {
+				logger.FromContext(ctx).Warn("Refresh token of a deleted user", zap.String("user_id", stored.UserID))
+				return ErrInvalidRefreshToken
+			}
+			return err
+		}
+
+		next, err := s.newRefreshToken(ctx, stored.UserID, stored.FamilyID)
+		if err != nil {
+			return err
+		}
+
+		if err := s.repo.CreateRefreshToken(ctx, next.model); err != nil {
+			return err
+		}
+
+		nextToken = next.raw
+		return s.repo.MarkRefreshTokenReplaced(ctx, stored.ID, next.model.ID)
+	})
+	if err != nil {
+		// Invalid and expired tokens were logged above; only repository and
+		// internal failures are errors.
+		if !errors.Is(err, ErrInvalidRefreshToken) {
+			logger.FromContext(ctx).Error("Failed to refresh token", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	if reused {
+		return nil, ErrRefreshTokenReused
+	}
+
+	logger.FromContext(ctx).Info("Refresh token rotated", zap.String("user_id", user.ID))
+	return s.tokenResponse(ctx, user, nextToken)
+}
+
+// Logout revokes the access token used for the request and, when given, the
+// refresh token family of the same session.
+func (s *Service) Logout(ctx context.Context, claims *auth.Claims, refreshToken string) error {
+	err := database.Transactional(ctx, func(ctx context.Context) error {
+		if refreshToken != "" {
+			stored, err := s.repo.FindRefreshTokenByHash(ctx, hashToken(refreshToken))
+			switch {
+			case errors.Is(err, repository.ErrRefreshTokenNotFound):
+				logger.FromContext(ctx).Warn("Unknown refresh token on logout", zap.String("user_id", claims.UserID))
+			case err != nil:
+				return err
+			case stored.UserID != claims.UserID:
+				logger.FromContext(ctx).Warn("Refresh token does not belong to user", zap.String("user_id", claims.UserID))
+				return ErrForeignRefreshToken
+			default:
+				if err := s.repo.RevokeRefreshTokenFamily(ctx, stored.FamilyID); err != nil {
+					return err
+				}
+			}
+		}
+
+		return s.revocations.Revoke(ctx, claims)
+	})
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to logout", zap.String("user_id", claims.UserID), zap.Error(err))
+		return err
+	}
+
+	logger.FromContext(ctx).Info("User logged out", zap.String("user_id", claims.UserID))
+	return nil
+}
+
+// LogoutAll ends every session of the user: all refresh tokens are revoked and
+// every access token issued so far stops being accepted.
+func (s *Service) LogoutAll(ctx context.Context, claims *auth.Claims) error {
+	err := database.Transactional(ctx, func(ctx context.Context) error {
+		if err := s.repo.RevokeUserRefreshTokens(ctx, claims.UserID); err != nil {
+			return err
+		}
+
+		return s.revocations.RevokeAll(ctx, claims.UserID)
+	})
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to logout from all devices", zap.String("user_id", claims.UserID), zap.Error(err))
+		return err
+	}
+
+	logger.FromContext(ctx).Info("User logged out from all devices", zap.String("user_id", claims.UserID))
+	return nil
+}
+
+func (s *Service) JWKS() *auth.JWKS {
+	return s.jwtService.JWKS()
+}
+
+func (s *Service) tokenResponse(ctx context.Context, user *model.User, refreshToken string) (*dto.TokenResponse, error) {
+	accessToken, err := s.jwtService.GenerateToken(ctx, user)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to generate JWT token", zap.String("user_id", user.ID), zap.Error(err))
+		return nil, err
+	}
+
+	return &dto.TokenResponse{
+		Token:        accessToken,
+		RefreshToken: refreshToken,
+		ExpiresIn:    int64(auth.AccessTokenTTL.Seconds()),
+	}, nil
+}
+
+func (s *Service) createRefreshToken(ctx context.Context, userID, familyID string) (string, error) {
+	token, err := s.newRefreshToken(ctx, userID, familyID)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.repo.CreateRefreshToken(ctx, token.model); err != nil {
+		return "", err
+	}
+
+	return token.raw, nil
+}
+
+type refreshToken struct {
+	raw   string
+	model *model.RefreshToken
+}
+
+func (s *Service) newRefreshToken(ctx context.Context, userID, familyID string) (*refreshToken, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		logger.FromContext(ctx).Error("Failed to generate refresh token", zap.Error(err))
+		return nil, err
+	}
+
+	raw := base64.RawURLEncoding.EncodeToString(buf)
+
+	return &refreshToken{
+		raw: raw,
+		model: &model.RefreshToken{
+			ID:        uuid.NewString(),
+			UserID:    userID,
+			FamilyID:  familyID,
+			TokenHash: hashToken(raw),
+			ExpiresAt: time.Now().Add(refreshTokenTTL),
+		},
+	}, nil
+}
+
+// hashToken stores only a digest of the refresh token, so a database dump
+// cannot be replayed against the refresh endpoint.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
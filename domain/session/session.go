@@ -0,0 +1,21 @@
+package session
+
+import (
+	"pokedex_backend_go/domain/session/handler"
+	"pokedex_backend_go/domain/session/repository"
+	"pokedex_backend_go/domain/session/service"
+	"pokedex_backend_go/pkg/server"
+
+	"go.uber.org/fx"
+)
+
+func SessionProvider() fx.Option {
+	return fx.Options(
+		fx.Provide(
+			repository.NewRepository,
+			service.NewService,
+			server.AsHandler(handler.Handler),
+			handler.NewHandler,
+		),
+	)
+}
@@ -0,0 +1,293 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"pokedex_backend_go/domain/team/repository"
+	"pokedex_backend_go/domain/team/service"
+	"pokedex_backend_go/pkg/auth"
+	"pokedex_backend_go/pkg/logger"
+	"pokedex_backend_go/pkg/model"
+	"pokedex_backend_go/pkg/problem"
+	"pokedex_backend_go/pkg/request"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type TeamHandler struct {
+	service *service.Service
+}
+
+func NewHandler(service *service.Service) *TeamHandler {
+	return &TeamHandler{
+		service: service,
+	}
+}
+
+func Handler(service *service.Service, authMiddleware *auth.AuthMiddleware) func(chi.Router) {
+	return func(r chi.Router) {
+		logger := zap.L().Named("team_handler_registration")
+		logger.Info("Registering team handler at /api/v1/teams")
+
+		handler := NewHandler(service)
+
+		r.With(authMiddleware.RequireAuth).Get("/api/v1/teams", handler.ListTeams)
+		r.With(authMiddleware.RequireAuth).Post("/api/v1/teams", handler.CreateTeam)
+		r.With(authMiddleware.RequireAuth).Post("/api/v1/teams/import", handler.ImportTeam)
+		r.With(authMiddleware.RequireAuth).Get("/api/v1/teams/{id}/export", handler.ExportTeam)
+		r.With(authMiddleware.RequireAuth).Get("/api/v1/teams/{id}", handler.GetTeam)
+		r.With(authMiddleware.RequireAuth).Put("/api/v1/teams/{id}", handler.UpdateTeam)
+		r.With(authMiddleware.RequireAuth).Delete("/api/v1/teams/{id}", handler.DeleteTeam)
+	}
+}
+
+type MemberPayload struct {
+	Pokemon  string            `json:"pokemon"`
+	Nickname string            `json:"nickname"`
+	Level    int               `json:"level"`
+	Ability  string            `json:"ability"`
+	Item     string            `json:"item"`
+	Nature   string            `json:"nature"`
+	Shiny    bool              `json:"shiny"`
+	Moves    []string          `json:"moves"`
+	EVs      *model.StatSpread `json:"evs"`
+	IVs      *model.StatSpread `json:"ivs"`
+}
+
+type TeamPayload struct {
+	Name    string          `json:"name"`
+	Format  string          `json:"format"`
+	Members []MemberPayload `json:"members"`
+}
+
+type ImportPayload struct {
+	Name   string `json:"name"`
+	Format string `json:"format"`
+	Paste  string `json:"paste"`
+}
+
+func (p TeamPayload) input() service.TeamInput {
+	input := service.TeamInput{
+		Name:    p.Name,
+		Format:  p.Format,
+		Members: make([]service.MemberInput, 0, len(p.Members)),
+	}
+
+	for _, m := range p.Members {
+		input.Members = append(input.Members, service.MemberInput{
+			Pokemon:  m.Pokemon,
+			Nickname: m.Nickname,
+			Level:    m.Level,
+			Ability:  m.Ability,
+			Item:     m.Item,
+			Nature:   m.Nature,
+			Shiny:    m.Shiny,
+			Moves:    m.Moves,
+			EVs:      m.EVs,
+			IVs:      m.IVs,
+		})
+	}
+
+	return input
+}
+
+func (handler *TeamHandler) ListTeams(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth.GetUserFromContext(r.Context())
+	if !ok {
+		logger.FromContext(r.Context()).Error("Failed to get user from context")
+		problem.Write(w, r, problem.ErrInternal)
+		return
+	}
+
+	ctx := r.Context()
+	response, err := handler.service.List(ctx, claims.UserID)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to list teams", zap.Error(err))
+		problem.Write(w, r, problem.ErrInternal)
+		return
+	}
+
+	handler.writeJSON(w, r, http.StatusOK, response)
+}
+
+func (handler *TeamHandler) GetTeam(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth.GetUserFromContext(r.Context())
+	if !ok {
+		logger.FromContext(r.Context()).Error("Failed to get user from context")
+		problem.Write(w, r, problem.ErrInternal)
+		return
+	}
+
+	teamID, ok := teamIDParam(r)
+	if !ok {
+		problem.Write(w, r, repository.ErrTeamNotFound)
+		return
+	}
+
+	ctx := r.Context()
+	response, err := handler.service.Get(ctx, claims.UserID, teamID)
+	if err != nil {
+		handler.writeError(w, r, err, "Failed to get team")
+		return
+	}
+
+	handler.writeJSON(w, r, http.StatusOK, response)
+}
+
+func (handler *TeamHandler) CreateTeam(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth.GetUserFromContext(r.Context())
+	if !ok {
+		logger.FromContext(r.Context()).Error("Failed to get user from context")
+		problem.Write(w, r, problem.ErrInternal)
+		return
+	}
+
+	var req TeamPayload
+	if err := request.Decode(w, r, &req); err != nil {
+		problem.Write(w, r, err)
+		return
+	}
+
+	ctx := r.Context()
+	response, err := handler.service.Create(ctx, claims.UserID, req.input())
+	if err != nil {
+		handler.writeError(w, r, err, "Failed to create team")
+		return
+	}
+
+	handler.writeJSON(w, r, http.StatusCreated, response)
+}
+
+func (handler *TeamHandler) ImportTeam(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth.GetUserFromContext(r.Context())
+	if !ok {
+		logger.FromContext(r.Context()).Error("Failed to get user from context")
+		problem.Write(w, r, problem.ErrInternal)
+		return
+	}
+
+	var req ImportPayload
+	if err := request.Decode(w, r, &req); err != nil {
+		problem.Write(w, r, err)
+		return
+	}
+
+	ctx := r.Context()
+	response, err := handler.service.Import(ctx, claims.UserID, req.Name, req.Format, req.Paste)
+	if err != nil {
+		handler.writeError(w, r, err, "Failed to import team")
+		return
+	}
+
+	handler.writeJSON(w, r, http.StatusCreated, response)
+}
+
+func (handler *TeamHandler) ExportTeam(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth.GetUserFromContext(r.Context())
+	if !ok {
+		logger.FromContext(r.Context()).Error("Failed to get user from context")
+		problem.Write(w, r, problem.ErrInternal)
+		return
+	}
+
+	teamID, ok := teamIDParam(r)
+	if !ok {
+		problem.Write(w, r, repository.ErrTeamNotFound)
+		return
+	}
+
+	ctx := r.Context()
+	paste, err := handler.service.Export(ctx, claims.UserID, teamID)
+	if err != nil {
+		handler.writeError(w, r, err, "Failed to export team")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write([]byte(paste)); err != nil {
+		logger.FromContext(ctx).Error("Failed to write team paste", zap.Error(err))
+	}
+}
+
+func (handler *TeamHandler) UpdateTeam(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth.GetUserFromContext(r.Context())
+	if !ok {
+		logger.FromContext(r.Context()).Error("Failed to get user from context")
+		problem.Write(w, r, problem.ErrInternal)
+		return
+	}
+
+	teamID, ok := teamIDParam(r)
+	if !ok {
+		problem.Write(w, r, repository.ErrTeamNotFound)
+		return
+	}
+
+	var req TeamPayload
+	if err := request.Decode(w, r, &req); err != nil {
+		problem.Write(w, r, err)
+		return
+	}
+
+	ctx := r.Context()
+	response, err := handler.service.Update(ctx, claims.UserID, teamID, req.input())
+	if err != nil {
+		handler.writeError(w, r, err, "Failed to update team")
+		return
+	}
+
+	handler.writeJSON(w, r, http.StatusOK, response)
+}
+
+func (handler *TeamHandler) DeleteTeam(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth.GetUserFromContext(r.Context())
+	if !ok {
+		logger.FromContext(r.Context()).Error("Failed to get user from context")
+		problem.Write(w, r, problem.ErrInternal)
+		return
+	}
+
+	teamID, ok := teamIDParam(r)
+	if !ok {
+		problem.Write(w, r, repository.ErrTeamNotFound)
+		return
+	}
+
+	ctx := r.Context()
+	if err := handler.service.Delete(ctx, claims.UserID, teamID); err != nil {
+		handler.writeError(w, r, err, "Failed to delete team")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// teamIDParam rejects ids that are not UUIDs up front, since Postgres would
+// fail on them instead of finding nothing.
+func teamIDParam(r *http.Request) (string, bool) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		return "", false
+	}
+
+	return id.String(), true
+}
+
+func (handler *TeamHandler) writeError(w http.ResponseWriter, r *http.Request, err error, message string) {
+	if problem.Internal(err) {
+		logger.FromContext(r.Context()).Error(message, zap.Error(err))
+	}
+	problem.Write(w, r, err)
+}
+
+func (handler *TeamHandler) writeJSON(w http.ResponseWriter, r *http.Request, status int, response any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.FromContext(r.Context()).Error("Failed to encode team response", zap.Error(err))
+	}
+}
@@ -0,0 +1,249 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"pokedex_backend_go/pkg/database"
+	"pokedex_backend_go/pkg/logger"
+	"pokedex_backend_go/pkg/model"
+	"pokedex_backend_go/pkg/problem"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+var ErrTeamNotFound = problem.New(http.StatusNotFound, "team_not_found", "Team not found")
+
+func NewRepository() *Repository {
+	return &Repository{}
+}
+
+type Repository struct {
+}
+
+// withMembers loads the members of the teams in slot order along with the
+// catalog entries needed to render them.
+func withMembers(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("Members", func(db *gorm.DB) *gorm.DB { return db.Order("slot") }).
+		Preload("Members.Pokemon.Species").
+		Preload("Members.Ability").
+		Preload("Members.Nature").
+		Preload("Members.Moves", func(db *gorm.DB) *gorm.DB { return db.Order("slot") }).
+		Preload("Members.Moves.Move")
+}
+
+func (r *Repository) ListTeams(ctx context.Context, userID string) ([]model.Team, error) {
+	orm := database.Orm(ctx)
+
+	var teams []model.Team
+	result := withMembers(orm.WithContext(ctx)).
+		Where("user_id = ?", userID).
+		Order("created_at").
+		Find(&teams)
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to list teams", zap.String("user_id", userID), zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	return teams, nil
+}
+
+// GetTeam only returns teams owned by the user; other users' teams are
+// reported as not found.
+func (r *Repository) GetTeam(ctx context.Context, userID, teamID string) (*model.Team, error) {
+	orm := database.Orm(ctx)
+
+	var team model.Team
+	result := withMembers(orm.WithContext(ctx)).
+		Where("id = ? AND user_id = ?", teamID, userID).
+		First(&team)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			logger.FromContext(ctx).Warn("Team not found", zap.String("team_id", teamID))
+			return nil, ErrTeamNotFound
+		}
+		logger.FromContext(ctx).Error("Failed to get team", zap.String("team_id", teamID), zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	return &team, nil
+}
+
+// CreateTeam inserts the team together with its members and their moves.
+func (r *Repository) CreateTeam(ctx context.Context, team *model.Team) error {
+	orm := database.Orm(ctx)
+
+	result := orm.WithContext(ctx).Create(team)
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to create team", zap.String("user_id", team.UserID), zap.Error(result.Error))
+		return result.Error
+	}
+
+	logger.FromContext(ctx).Debug("Team created", zap.String("team_id", team.ID), zap.Int("members", len(team.Members)))
+	return nil
+}
+
+// ReplaceTeam overwrites the name, format and members of an existing team.
+// It must run in a transaction so a failure keeps the previous members.
+func (r *Repository) ReplaceTeam(ctx context.Context, team *model.Team) error {
+	orm := database.Orm(ctx)
+
+	result := orm.WithContext(ctx).Model(&model.Team{}).
+		Where("id = ? AND user_id = ?", team.ID, team.UserID).
+		Updates(map[string]interface{}{
+			"name":   team.Name,
+			"format": team.Format,
+		})
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to update team", zap.String("team_id", team.ID), zap.Error(result.Error))
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrTeamNotFound
+	}
+
+	// Moves are removed by the ON DELETE CASCADE of team_member_moves.
+	result = orm.WithContext(ctx).Where("team_id = ?", team.ID).Delete(&model.TeamMember{})
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to delete team members", zap.String("team_id", team.ID), zap.Error(result.Error))
+		return result.Error
+	}
+
+	if len(team.Members) == 0 {
+		return nil
+	}
+
+	for i := range team.Members {
+		team.Members[i].TeamID = team.ID
+	}
+
+	result = orm.WithContext(ctx).Create(&team.Members)
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to create team members", zap.String("team_id", team.ID), zap.Error(result.Error))
+		return result.Error
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteTeam(ctx context.Context, userID, teamID string) error {
+	orm := database.Orm(ctx)
+
+	result := orm.WithContext(ctx).Where("id = ? AND user_id = ?", teamID, userID).Delete(&model.Team{})
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to delete team", zap.String("team_id", teamID), zap.Error(result.Error))
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrTeamNotFound
+	}
+
+	logger.FromContext(ctx).Info("Team deleted", zap.String("team_id", teamID))
+	return nil
+}
+
+// PokemonByNames returns the varieties with the given names, keyed by name,
+// with their species and the abilities they can have. Like
+// pokemon.Repository.GetVariety, species names resolve to their default
+// variety, e.g. mimikyu to mimikyu-disguised, which is how Showdown names
+// them.
+func (r *Repository) PokemonByNames(ctx context.Context, names []string) (map[string]*model.Pokemon, error) {
+	found := make(map[string]*model.Pokemon, len(names))
+	if len(names) == 0 {
+		return found, nil
+	}
+
+	orm := database.Orm(ctx)
+
+	var pokemon []model.Pokemon
+	result := orm.WithContext(ctx).
+		Preload("Species").
+		Preload("Abilities.Ability").
+		Where("name IN ? OR (is_default AND species_id IN (SELECT id FROM pokemon_species WHERE name IN ?))", names, names).
+		Find(&pokemon)
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to look up pokemon", zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	for i := range pokemon {
+		if pokemon[i].IsDefault && pokemon[i].Species != nil {
+			found[pokemon[i].Species.Name] = &pokemon[i]
+		}
+	}
+	// A variety named like a species wins over that species' default.
+	for i := range pokemon {
+		found[pokemon[i].Name] = &pokemon[i]
+	}
+
+	return found, nil
+}
+
+func (r *Repository) MovesByNames(ctx context.Context, names []string) (map[string]*model.Move, error) {
+	found := make(map[string]*model.Move, len(names))
+	if len(names) == 0 {
+		return found, nil
+	}
+
+	orm := database.Orm(ctx)
+
+	var moves []model.Move
+	result := orm.WithContext(ctx).Where("name IN ?", names).Find(&moves)
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to look up moves", zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	for i := range moves {
+		found[moves[i].Name] = &moves[i]
+	}
+
+	return found, nil
+}
+
+func (r *Repository) ItemsByNames(ctx context.Context, names []string) (map[string]*model.Item, error) {
+	found := make(map[string]*model.Item, len(names))
+	if len(names) == 0 {
+		return found, nil
+	}
+
+	orm := database.Orm(ctx)
+
+	var items []model.Item
+	result := orm.WithContext(ctx).Where("name IN ?", names).Find(&items)
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to look up items", zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	for i := range items {
+		found[items[i].Name] = &items[i]
+	}
+
+	return found, nil
+}
+
+func (r *Repository) NaturesByNames(ctx context.Context, names []string) (map[string]*model.Nature, error) {
+	found := make(map[string]*model.Nature, len(names))
+	if len(names) == 0 {
+		return found, nil
+	}
+
+	orm := database.Orm(ctx)
+
+	var natures []model.Nature
+	result := orm.WithContext(ctx).Where("name IN ?", names).Find(&natures)
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to look up natures", zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	for i := range natures {
+		found[natures[i].Name] = &natures[i]
+	}
+
+	return found, nil
+}
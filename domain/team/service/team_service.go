@@ -0,0 +1,515 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"pokedex_backend_go/domain/team/repository"
+	"pokedex_backend_go/pkg/database"
+	"pokedex_backend_go/pkg/dto"
+	"pokedex_backend_go/pkg/identifier"
+	"pokedex_backend_go/pkg/logger"
+	"pokedex_backend_go/pkg/model"
+	"pokedex_backend_go/pkg/showdown"
+	"pokedex_backend_go/pkg/validation"
+
+	"go.uber.org/zap"
+)
+
+const (
+	MaxMembers      = 6
+	MaxMoves        = 4
+	MaxNameLength   = 100
+	MaxLevel        = 100
+	MaxEV           = 252
+	MaxEVTotal      = 510
+	MaxIV           = 31
+	DefaultLevel    = 100
+	maxNickLength   = 50
+	maxFormatLength = 50
+)
+
+// TeamInput is a team as sent by the client. Catalog entries are referenced
+// by name, either as PokeAPI identifiers ("great-tusk") or display names
+// ("Great Tusk").
+type TeamInput struct {
+	Name    string
+	Format  string
+	Members []MemberInput
+}
+
+// MemberInput is a team member. A zero Level means level 100, missing EVs
+// mean none and missing IVs mean 31 in every stat. Lines is set when the
+// member comes from a Showdown paste so errors can point at the paste.
+type MemberInput struct {
+	Pokemon  string
+	Nickname string
+	Level    int
+	Ability  string
+	Item     string
+	Nature   string
+	Shiny    bool
+	Moves    []string
+	EVs      *model.StatSpread
+	IVs      *model.StatSpread
+	Lines    showdown.Lines
+}
+
+func NewService(repo *repository.Repository) *Service {
+	return &Service{
+		repo: repo,
+	}
+}
+
+type Service struct {
+	repo *repository.Repository
+}
+
+func (s *Service) List(ctx context.Context, userID string) (*dto.TeamListResponse, error) {
+	teams, err := s.repo.ListTeams(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.TeamListResponse{Teams: make([]dto.TeamResponse, 0, len(teams))}
+	for i := range teams {
+		response.Teams = append(response.Teams, toResponse(&teams[i]))
+	}
+
+	return response, nil
+}
+
+func (s *Service) Get(ctx context.Context, userID, teamID string) (*dto.TeamResponse, error) {
+	team, err := s.repo.GetTeam(ctx, userID, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := toResponse(team)
+	return &response, nil
+}
+
+// Create validates the team and stores it. Illegal builds are reported as
+// validation.Errors listing every offending field.
+func (s *Service) Create(ctx context.Context, userID string, input TeamInput) (*dto.TeamResponse, error) {
+	team, err := s.build(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	team.UserID = userID
+
+	var created *model.Team
+	err = database.Transactional(ctx, func(ctx context.Context) error {
+		if err := s.repo.CreateTeam(ctx, team); err != nil {
+			return err
+		}
+
+		created, err = s.repo.GetTeam(ctx, userID, team.ID)
+		return err
+	})
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to create team", zap.String("user_id", userID), zap.Error(err))
+		return nil, err
+	}
+
+	logger.FromContext(ctx).Info("Team created", zap.String("user_id", userID), zap.String("team_id", created.ID))
+	response := toResponse(created)
+	return &response, nil
+}
+
+// Import stores a team pasted from Showdown. Syntax errors and illegal sets
+// are reported as validation.Errors pointing at the lines of the paste. The
+// name and format of the request win over the ones in the paste header.
+func (s *Service) Import(ctx context.Context, userID, name, format, paste string) (*dto.TeamResponse, error) {
+	parsed, err := showdown.Parse(paste)
+	if err != nil {
+		var syntax showdown.Errors
+		if !errors.As(err, &syntax) {
+			return nil, err
+		}
+
+		var errs validation.Errors
+		for _, e := range syntax {
+			errs.AddLine("paste", e.Line, "invalid_syntax", e.Format, e.Args...)
+		}
+		return nil, errs
+	}
+
+	if len(parsed.Sets) == 0 {
+		var errs validation.Errors
+		errs.Add("paste", "required", "the paste contains no pokemon")
+		return nil, errs
+	}
+
+	input := TeamInput{
+		Name:    firstNonEmpty(name, parsed.Name, "Imported team"),
+		Format:  firstNonEmpty(format, parsed.Format),
+		Members: make([]MemberInput, 0, len(parsed.Sets)),
+	}
+	for _, set := range parsed.Sets {
+		input.Members = append(input.Members, MemberInput{
+			Pokemon:  set.Species,
+			Nickname: set.Nickname,
+			Level:    set.Level,
+			Ability:  set.Ability,
+			Item:     set.Item,
+			Nature:   set.Nature,
+			Shiny:    set.Shiny,
+			Moves:    set.Moves,
+			EVs:      set.EVs,
+			IVs:      set.IVs,
+			Lines:    set.Lines,
+		})
+	}
+
+	return s.Create(ctx, userID, input)
+}
+
+// Export renders the team as a Showdown paste.
+func (s *Service) Export(ctx context.Context, userID, teamID string) (string, error) {
+	team, err := s.repo.GetTeam(ctx, userID, teamID)
+	if err != nil {
+		return "", err
+	}
+
+	paste := &showdown.Team{
+		Format: team.Format,
+		Name:   team.Name,
+		Sets:   make([]showdown.Set, 0, len(team.Members)),
+	}
+	for _, m := range team.Members {
+		evs, ivs := m.EVs, m.IVs
+		set := showdown.Set{
+			Nickname: m.Nickname,
+			Level:    m.Level,
+			Shiny:    m.Shiny,
+			EVs:      &evs,
+			IVs:      &ivs,
+		}
+
+		if m.Pokemon != nil {
+			if m.Pokemon.Species != nil {
+				set.Species = showdown.PokemonName(m.Pokemon.Species.Name, m.Pokemon.Name, m.Pokemon.IsDefault)
+			} else {
+				set.Species = showdown.DisplayName(m.Pokemon.Name)
+			}
+		}
+		if m.Item != "" {
+			set.Item = showdown.DisplayName(m.Item)
+		}
+		if m.Ability != nil {
+			set.Ability = showdown.DisplayName(m.Ability.Name)
+		}
+		if m.Nature != nil {
+			set.Nature = showdown.DisplayName(m.Nature.Name)
+		}
+		for _, move := range m.Moves {
+			if move.Move != nil {
+				set.Moves = append(set.Moves, showdown.DisplayName(move.Move.Name))
+			}
+		}
+
+		paste.Sets = append(paste.Sets, set)
+	}
+
+	return showdown.Format(paste), nil
+}
+
+// Update replaces the whole team, members included.
+func (s *Service) Update(ctx context.Context, userID, teamID string, input TeamInput) (*dto.TeamResponse, error) {
+	team, err := s.build(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	team.ID = teamID
+	team.UserID = userID
+
+	var updated *model.Team
+	err = database.Transactional(ctx, func(ctx context.Context) error {
+		if err := s.repo.ReplaceTeam(ctx, team); err != nil {
+			return err
+		}
+
+		updated, err = s.repo.GetTeam(ctx, userID, teamID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := toResponse(updated)
+	return &response, nil
+}
+
+func (s *Service) Delete(ctx context.Context, userID, teamID string) error {
+	return s.repo.DeleteTeam(ctx, userID, teamID)
+}
+
+// build resolves the catalog names of the input and checks the legality of
+// every member, collecting all problems before giving up.
+func (s *Service) build(ctx context.Context, input TeamInput) (*model.Team, error) {
+	var errs validation.Errors
+
+	team := &model.Team{
+		Name:   strings.TrimSpace(input.Name),
+		Format: identifier.Normalize(input.Format),
+	}
+
+	switch {
+	case team.Name == "":
+		errs.Add("name", "required", "name is required")
+	case len(team.Name) > MaxNameLength:
+		errs.Add("name", "too_long", "name must be at most %d characters", MaxNameLength)
+	}
+
+	if len(team.Format) > maxFormatLength {
+		errs.Add("format", "too_long", "format must be at most %d characters", maxFormatLength)
+	}
+
+	if len(input.Members) > MaxMembers {
+		errs.Add("members", "too_many", "a team has at most %d members", MaxMembers)
+		return nil, errs
+	}
+
+	refs, err := s.lookup(ctx, input.Members)
+	if err != nil {
+		return nil, err
+	}
+
+	speciesSlot := make(map[int]int, len(input.Members))
+	for i, in := range input.Members {
+		field := fmt.Sprintf("members[%d]", i)
+		member := model.TeamMember{
+			Slot:     i + 1,
+			Nickname: strings.TrimSpace(in.Nickname),
+			Level:    in.Level,
+			Item:     identifier.Normalize(in.Item),
+			Shiny:    in.Shiny,
+			EVs:      model.StatSpread{},
+			IVs:      model.StatSpread{HP: MaxIV, Attack: MaxIV, Defense: MaxIV, SpecialAttack: MaxIV, SpecialDefense: MaxIV, Speed: MaxIV},
+		}
+		if member.Level == 0 {
+			member.Level = DefaultLevel
+		}
+		if in.EVs != nil {
+			member.EVs = *in.EVs
+		}
+		if in.IVs != nil {
+			member.IVs = *in.IVs
+		}
+
+		lines := in.Lines
+
+		pokemon, ok := refs.pokemon[identifier.Normalize(in.Pokemon)]
+		if !ok {
+			errs.AddLine(field+".pokemon", lines.Species, "unknown_pokemon", "unknown pokemon %q", in.Pokemon)
+		} else {
+			member.PokemonID = pokemon.ID
+
+			// Species Clause: two formes of the same species, e.g. rotom-wash
+			// and rotom-heat, count as duplicates too.
+			if first, duplicate := speciesSlot[pokemon.SpeciesID]; duplicate {
+				errs.AddLine(field+".pokemon", lines.Species, "species_clause", "species already used by members[%d]", first)
+			} else {
+				speciesSlot[pokemon.SpeciesID] = i
+			}
+
+			abilityID, ok := abilityOf(pokemon, identifier.Normalize(in.Ability))
+			switch {
+			case strings.TrimSpace(in.Ability) == "":
+				errs.AddLine(field+".ability", lines.Species, "required", "ability is required")
+			case !ok:
+				errs.AddLine(field+".ability", lines.Ability, "ability_not_available", "%s cannot have the ability %q", pokemon.Name, in.Ability)
+			default:
+				member.AbilityID = abilityID
+			}
+		}
+
+		if len(member.Nickname) > maxNickLength {
+			errs.AddLine(field+".nickname", lines.Species, "too_long", "nickname must be at most %d characters", maxNickLength)
+		}
+
+		if member.Level < 1 || member.Level > MaxLevel {
+			errs.AddLine(field+".level", lines.Level, "out_of_range", "level must be between 1 and %d", MaxLevel)
+		}
+
+		if member.Item != "" {
+			if _, ok := refs.items[member.Item]; !ok {
+				errs.AddLine(field+".item", lines.Item, "unknown_item", "unknown item %q", in.Item)
+			}
+		}
+
+		if strings.TrimSpace(in.Nature) != "" {
+			nature, ok := refs.natures[identifier.Normalize(in.Nature)]
+			if !ok {
+				errs.AddLine(field+".nature", lines.Nature, "unknown_nature", "unknown nature %q", in.Nature)
+			} else {
+				member.NatureID = &nature.ID
+			}
+		}
+
+		if len(in.Moves) > MaxMoves {
+			errs.AddLine(field+".moves", lines.Species, "too_many", "a pokemon knows at most %d moves", MaxMoves)
+		}
+		seenMoves := make(map[int]bool, len(in.Moves))
+		for j, name := range in.Moves {
+			moveField := fmt.Sprintf("%s.moves[%d]", field, j)
+			moveLine := 0
+			if j < len(lines.Moves) {
+				moveLine = lines.Moves[j]
+			}
+
+			move, ok := refs.moves[identifier.Normalize(name)]
+			switch {
+			case !ok:
+				errs.AddLine(moveField, moveLine, "unknown_move", "unknown move %q", name)
+			case seenMoves[move.ID]:
+				errs.AddLine(moveField, moveLine, "duplicate_move", "move %q is already known", name)
+			default:
+				seenMoves[move.ID] = true
+				member.Moves = append(member.Moves, model.TeamMemberMove{Slot: j + 1, MoveID: move.ID})
+			}
+		}
+
+		checkSpread(&errs, field+".evs", lines.EVs, member.EVs, MaxEV, "ev_out_of_range")
+		if total := member.EVs.Total(); total > MaxEVTotal {
+			errs.AddLine(field+".evs", lines.EVs, "ev_total_exceeded", "EVs add up to %d, the maximum is %d", total, MaxEVTotal)
+		}
+		checkSpread(&errs, field+".ivs", lines.IVs, member.IVs, MaxIV, "iv_out_of_range")
+
+		team.Members = append(team.Members, member)
+	}
+
+	if err := errs.Err(); err != nil {
+		logger.FromContext(ctx).Debug("Rejected illegal team", zap.Int("problems", len(errs)))
+		return nil, err
+	}
+
+	return team, nil
+}
+
+func checkSpread(errs *validation.Errors, field string, line int, spread model.StatSpread, max int, code string) {
+	values := []struct {
+		name  string
+		value int
+	}{
+		{"hp", spread.HP},
+		{"attack", spread.Attack},
+		{"defense", spread.Defense},
+		{"special_attack", spread.SpecialAttack},
+		{"special_defense", spread.SpecialDefense},
+		{"speed", spread.Speed},
+	}
+
+	for _, v := range values {
+		if v.value < 0 || v.value > max {
+			errs.AddLine(field+"."+v.name, line, code, "must be between 0 and %d, got %d", max, v.value)
+		}
+	}
+}
+
+func abilityOf(pokemon *model.Pokemon, name string) (int, bool) {
+	for _, a := range pokemon.Abilities {
+		if a.Ability.Name == name {
+			return a.AbilityID, true
+		}
+	}
+
+	return 0, false
+}
+
+// references holds the catalog entries named by a team, looked up with one
+// query per table.
+type references struct {
+	pokemon map[string]*model.Pokemon
+	moves   map[string]*model.Move
+	items   map[string]*model.Item
+	natures map[string]*model.Nature
+}
+
+func (s *Service) lookup(ctx context.Context, members []MemberInput) (*references, error) {
+	var pokemon, moves, items, natures []string
+	for _, m := range members {
+		pokemon = append(pokemon, identifier.Normalize(m.Pokemon))
+		if m.Item != "" {
+			items = append(items, identifier.Normalize(m.Item))
+		}
+		if m.Nature != "" {
+			natures = append(natures, identifier.Normalize(m.Nature))
+		}
+		for _, move := range m.Moves {
+			moves = append(moves, identifier.Normalize(move))
+		}
+	}
+
+	refs := &references{}
+	var err error
+	if refs.pokemon, err = s.repo.PokemonByNames(ctx, pokemon); err != nil {
+		return nil, err
+	}
+	if refs.moves, err = s.repo.MovesByNames(ctx, moves); err != nil {
+		return nil, err
+	}
+	if refs.items, err = s.repo.ItemsByNames(ctx, items); err != nil {
+		return nil, err
+	}
+	if refs.natures, err = s.repo.NaturesByNames(ctx, natures); err != nil {
+		return nil, err
+	}
+
+	return refs, nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+func toResponse(team *model.Team) dto.TeamResponse {
+	response := dto.TeamResponse{
+		ID:        team.ID,
+		Name:      team.Name,
+		Format:    team.Format,
+		Members:   make([]dto.TeamMemberResponse, 0, len(team.Members)),
+		CreatedAt: team.CreatedAt,
+		UpdatedAt: team.UpdatedAt,
+	}
+
+	for _, m := range team.Members {
+		member := dto.TeamMemberResponse{
+			Slot:     m.Slot,
+			Nickname: m.Nickname,
+			Level:    m.Level,
+			Item:     m.Item,
+			Shiny:    m.Shiny,
+			Moves:    make([]string, 0, len(m.Moves)),
+			EVs:      m.EVs,
+			IVs:      m.IVs,
+		}
+		if m.Pokemon != nil {
+			member.Pokemon = m.Pokemon.Name
+		}
+		if m.Ability != nil {
+			member.Ability = m.Ability.Name
+		}
+		if m.Nature != nil {
+			member.Nature = m.Nature.Name
+		}
+		for _, move := range m.Moves {
+			if move.Move != nil {
+				member.Moves = append(member.Moves, move.Move.Name)
+			}
+		}
+
+		response.Members = append(response.Members, member)
+	}
+
+	return response
+}
@@ -0,0 +1,21 @@
+package team
+
+import (
+	"pokedex_backend_go/domain/team/handler"
+	"pokedex_backend_go/domain/team/repository"
+	"pokedex_backend_go/domain/team/service"
+	"pokedex_backend_go/pkg/server"
+
+	"go.uber.org/fx"
+)
+
+func TeamProvider() fx.Option {
+	return fx.Options(
+		fx.Provide(
+			repository.NewRepository,
+			service.NewService,
+			server.AsHandler(handler.Handler),
+			handler.NewHandler,
+		),
+	)
+}
@@ -0,0 +1,105 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Los identificadores siguen la numeración de PokeAPI para poder importar sus datos sin traducir ids
+CREATE TABLE types (
+    id INTEGER PRIMARY KEY,
+    name VARCHAR(50) UNIQUE NOT NULL,
+    generation INTEGER NOT NULL
+);
+
+CREATE TABLE abilities (
+    id INTEGER PRIMARY KEY,
+    name VARCHAR(100) UNIQUE NOT NULL,
+    generation INTEGER NOT NULL,
+    is_main_series BOOLEAN NOT NULL DEFAULT TRUE
+);
+
+CREATE TABLE pokemon_species (
+    id INTEGER PRIMARY KEY,
+    name VARCHAR(100) UNIQUE NOT NULL,
+    generation INTEGER NOT NULL,
+    evolves_from_species_id INTEGER REFERENCES pokemon_species(id) ON DELETE SET NULL,
+    gender_rate INTEGER NOT NULL DEFAULT -1,
+    capture_rate INTEGER NOT NULL DEFAULT 0,
+    base_happiness INTEGER,
+    hatch_counter INTEGER,
+    is_baby BOOLEAN NOT NULL DEFAULT FALSE,
+    is_legendary BOOLEAN NOT NULL DEFAULT FALSE,
+    is_mythical BOOLEAN NOT NULL DEFAULT FALSE,
+    sort_order INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- Cada especie tiene una o más variedades (por ejemplo charizard, charizard-mega-x)
+CREATE TABLE pokemon (
+    id INTEGER PRIMARY KEY,
+    species_id INTEGER NOT NULL REFERENCES pokemon_species(id) ON DELETE CASCADE,
+    name VARCHAR(100) UNIQUE NOT NULL,
+    is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    height INTEGER NOT NULL DEFAULT 0,
+    weight INTEGER NOT NULL DEFAULT 0,
+    base_experience INTEGER,
+    sort_order INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE TABLE pokemon_forms (
+    id INTEGER PRIMARY KEY,
+    pokemon_id INTEGER NOT NULL REFERENCES pokemon(id) ON DELETE CASCADE,
+    name VARCHAR(100) UNIQUE NOT NULL,
+    form_name VARCHAR(100),
+    is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    is_battle_only BOOLEAN NOT NULL DEFAULT FALSE,
+    is_mega BOOLEAN NOT NULL DEFAULT FALSE,
+    form_order INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE TABLE pokemon_types (
+    pokemon_id INTEGER NOT NULL REFERENCES pokemon(id) ON DELETE CASCADE,
+    type_id INTEGER NOT NULL REFERENCES types(id) ON DELETE CASCADE,
+    slot INTEGER NOT NULL,
+    PRIMARY KEY (pokemon_id, slot)
+);
+
+CREATE TABLE pokemon_base_stats (
+    pokemon_id INTEGER PRIMARY KEY REFERENCES pokemon(id) ON DELETE CASCADE,
+    hp INTEGER NOT NULL,
+    attack INTEGER NOT NULL,
+    defense INTEGER NOT NULL,
+    special_attack INTEGER NOT NULL,
+    special_defense INTEGER NOT NULL,
+    speed INTEGER NOT NULL
+);
+
+CREATE TABLE pokemon_abilities (
+    pokemon_id INTEGER NOT NULL REFERENCES pokemon(id) ON DELETE CASCADE,
+    ability_id INTEGER NOT NULL REFERENCES abilities(id) ON DELETE CASCADE,
+    is_hidden BOOLEAN NOT NULL DEFAULT FALSE,
+    slot INTEGER NOT NULL,
+    PRIMARY KEY (pokemon_id, slot)
+);
+
+-- Índices para las búsquedas y relaciones más comunes
+CREATE INDEX idx_pokemon_species_generation ON pokemon_species(generation);
+CREATE INDEX idx_pokemon_species_sort_order ON pokemon_species(sort_order);
+CREATE INDEX idx_pokemon_species_id ON pokemon(species_id);
+CREATE INDEX idx_pokemon_forms_pokemon_id ON pokemon_forms(pokemon_id);
+CREATE INDEX idx_pokemon_types_type_id ON pokemon_types(type_id);
+CREATE INDEX idx_pokemon_abilities_ability_id ON pokemon_abilities(ability_id);
+
+CREATE TRIGGER update_pokemon_species_updated_at BEFORE UPDATE ON pokemon_species
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS update_pokemon_species_updated_at ON pokemon_species;
+DROP TABLE IF EXISTS pokemon_abilities;
+DROP TABLE IF EXISTS pokemon_base_stats;
+DROP TABLE IF EXISTS pokemon_types;
+DROP TABLE IF EXISTS pokemon_forms;
+DROP TABLE IF EXISTS pokemon;
+DROP TABLE IF EXISTS pokemon_species;
+DROP TABLE IF EXISTS abilities;
+DROP TABLE IF EXISTS types;
+-- +goose StatementEnd
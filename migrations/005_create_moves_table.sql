@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE moves (
+    id INTEGER PRIMARY KEY,
+    name VARCHAR(100) UNIQUE NOT NULL,
+    generation INTEGER NOT NULL,
+    type_id INTEGER NOT NULL REFERENCES types(id) ON DELETE CASCADE,
+    power INTEGER,
+    pp INTEGER,
+    accuracy INTEGER,
+    priority INTEGER NOT NULL DEFAULT 0,
+    damage_class VARCHAR(20) NOT NULL,
+    effect_chance INTEGER
+);
+
+CREATE INDEX idx_moves_type_id ON moves(type_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS moves;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Grupos de versiones (por ejemplo scarlet-violet) y versiones individuales (scarlet, violet)
+CREATE TABLE version_groups (
+    id INTEGER PRIMARY KEY,
+    name VARCHAR(100) UNIQUE NOT NULL,
+    generation INTEGER NOT NULL,
+    sort_order INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE TABLE versions (
+    id INTEGER PRIMARY KEY,
+    name VARCHAR(100) UNIQUE NOT NULL,
+    version_group_id INTEGER NOT NULL REFERENCES version_groups(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_versions_version_group_id ON versions(version_group_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS versions;
+DROP TABLE IF EXISTS version_groups;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE user_pokedex_entries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    species_id INTEGER NOT NULL REFERENCES pokemon_species(id) ON DELETE CASCADE,
+    form_id INTEGER REFERENCES pokemon_forms(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('seen', 'caught', 'shiny')),
+    version_id INTEGER REFERENCES versions(id) ON DELETE SET NULL,
+    marked_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- Una marca por usuario, especie, forma y estado; form_id NULL representa la especie en general
+CREATE UNIQUE INDEX idx_user_pokedex_entries_unique
+    ON user_pokedex_entries(user_id, species_id, form_id, status) NULLS NOT DISTINCT;
+CREATE INDEX idx_user_pokedex_entries_user_status ON user_pokedex_entries(user_id, status);
+
+CREATE TRIGGER update_user_pokedex_entries_updated_at BEFORE UPDATE ON user_pokedex_entries
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS update_user_pokedex_entries_updated_at ON user_pokedex_entries;
+DROP TABLE IF EXISTS user_pokedex_entries;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Pokédex regionales (kanto, paldea, ...) y el número de cada especie en ellas
+CREATE TABLE pokedexes (
+    id INTEGER PRIMARY KEY,
+    name VARCHAR(100) UNIQUE NOT NULL,
+    region_id INTEGER,
+    is_main_series BOOLEAN NOT NULL DEFAULT TRUE
+);
+
+CREATE TABLE pokedex_numbers (
+    pokedex_id INTEGER NOT NULL REFERENCES pokedexes(id) ON DELETE CASCADE,
+    species_id INTEGER NOT NULL REFERENCES pokemon_species(id) ON DELETE CASCADE,
+    number INTEGER NOT NULL,
+    PRIMARY KEY (pokedex_id, species_id)
+);
+
+CREATE INDEX idx_pokedex_numbers_species_id ON pokedex_numbers(species_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS pokedex_numbers;
+DROP TABLE IF EXISTS pokedexes;
+-- +goose StatementEnd
@@ -0,0 +1,43 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Naturalezas con los ids de PokeAPI; las neutras no aumentan ni reducen ninguna estadística
+CREATE TABLE natures (
+    id INTEGER PRIMARY KEY,
+    name VARCHAR(50) UNIQUE NOT NULL,
+    increased_stat VARCHAR(20) CHECK (increased_stat IN ('attack', 'defense', 'special_attack', 'special_defense', 'speed')),
+    decreased_stat VARCHAR(20) CHECK (decreased_stat IN ('attack', 'defense', 'special_attack', 'special_defense', 'speed')),
+    CHECK ((increased_stat IS NULL) = (decreased_stat IS NULL))
+);
+
+INSERT INTO natures (id, name, increased_stat, decreased_stat) VALUES
+    (1, 'hardy', NULL, NULL),
+    (2, 'bold', 'defense', 'attack'),
+    (3, 'modest', 'special_attack', 'attack'),
+    (4, 'calm', 'special_defense', 'attack'),
+    (5, 'timid', 'speed', 'attack'),
+    (6, 'lonely', 'attack', 'defense'),
+    (7, 'docile', NULL, NULL),
+    (8, 'mild', 'special_attack', 'defense'),
+    (9, 'gentle', 'special_defense', 'defense'),
+    (10, 'hasty', 'speed', 'defense'),
+    (11, 'adamant', 'attack', 'special_attack'),
+    (12, 'impish', 'defense', 'special_attack'),
+    (13, 'bashful', NULL, NULL),
+    (14, 'careful', 'special_defense', 'special_attack'),
+    (15, 'rash', 'special_attack', 'special_defense'),
+    (16, 'jolly', 'speed', 'special_attack'),
+    (17, 'naughty', 'attack', 'special_defense'),
+    (18, 'lax', 'defense', 'special_defense'),
+    (19, 'quirky', NULL, NULL),
+    (20, 'naive', 'speed', 'special_defense'),
+    (21, 'brave', 'attack', 'speed'),
+    (22, 'relaxed', 'defense', 'speed'),
+    (23, 'quiet', 'special_attack', 'speed'),
+    (24, 'sassy', 'special_defense', 'speed'),
+    (25, 'serious', NULL, NULL);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS natures;
+-- +goose StatementEnd
@@ -0,0 +1,58 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE teams (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    format VARCHAR(50) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX idx_teams_user_id ON teams(user_id);
+
+CREATE TRIGGER update_teams_updated_at BEFORE UPDATE ON teams
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- pokemon_id apunta a la variedad (por ejemplo rotom-wash) y no a la especie
+CREATE TABLE team_members (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    slot INTEGER NOT NULL CHECK (slot BETWEEN 1 AND 6),
+    pokemon_id INTEGER NOT NULL REFERENCES pokemon(id),
+    nickname VARCHAR(50) NOT NULL DEFAULT '',
+    level INTEGER NOT NULL DEFAULT 100 CHECK (level BETWEEN 1 AND 100),
+    ability_id INTEGER NOT NULL REFERENCES abilities(id),
+    item VARCHAR(100) NOT NULL DEFAULT '',
+    nature_id INTEGER REFERENCES natures(id),
+    shiny BOOLEAN NOT NULL DEFAULT FALSE,
+    ev_hp INTEGER NOT NULL DEFAULT 0,
+    ev_attack INTEGER NOT NULL DEFAULT 0,
+    ev_defense INTEGER NOT NULL DEFAULT 0,
+    ev_special_attack INTEGER NOT NULL DEFAULT 0,
+    ev_special_defense INTEGER NOT NULL DEFAULT 0,
+    ev_speed INTEGER NOT NULL DEFAULT 0,
+    iv_hp INTEGER NOT NULL DEFAULT 31,
+    iv_attack INTEGER NOT NULL DEFAULT 31,
+    iv_defense INTEGER NOT NULL DEFAULT 31,
+    iv_special_attack INTEGER NOT NULL DEFAULT 31,
+    iv_special_defense INTEGER NOT NULL DEFAULT 31,
+    iv_speed INTEGER NOT NULL DEFAULT 31,
+    UNIQUE (team_id, slot)
+);
+
+CREATE TABLE team_member_moves (
+    member_id UUID NOT NULL REFERENCES team_members(id) ON DELETE CASCADE,
+    slot INTEGER NOT NULL CHECK (slot BETWEEN 1 AND 4),
+    move_id INTEGER NOT NULL REFERENCES moves(id),
+    PRIMARY KEY (member_id, slot)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS team_member_moves;
+DROP TABLE IF EXISTS team_members;
+DROP TRIGGER IF EXISTS update_teams_updated_at ON teams;
+DROP TABLE IF EXISTS teams;
+-- +goose StatementEnd
@@ -0,0 +1,47 @@
+-- +goose Up
+-- +goose StatementBegin
+-- La característica indica la estadística con el IV más alto y el resto de dividir ese IV entre 5
+CREATE TABLE characteristics (
+    stat VARCHAR(20) NOT NULL CHECK (stat IN ('hp', 'attack', 'defense', 'special_attack', 'special_defense', 'speed')),
+    iv_remainder INTEGER NOT NULL CHECK (iv_remainder BETWEEN 0 AND 4),
+    description VARCHAR(100) UNIQUE NOT NULL,
+    PRIMARY KEY (stat, iv_remainder)
+);
+
+INSERT INTO characteristics (stat, iv_remainder, description) VALUES
+    ('hp', 0, 'Loves to eat'),
+    ('hp', 1, 'Takes plenty of siestas'),
+    ('hp', 2, 'Nods off a lot'),
+    ('hp', 3, 'Scatters things often'),
+    ('hp', 4, 'Likes to relax'),
+    ('attack', 0, 'Proud of its power'),
+    ('attack', 1, 'Likes to thrash about'),
+    ('attack', 2, 'A little quick tempered'),
+    ('attack', 3, 'Likes to fight'),
+    ('attack', 4, 'Quick tempered'),
+    ('defense', 0, 'Sturdy body'),
+    ('defense', 1, 'Capable of taking hits'),
+    ('defense', 2, 'Highly persistent'),
+    ('defense', 3, 'Good endurance'),
+    ('defense', 4, 'Good perseverance'),
+    ('special_attack', 0, 'Highly curious'),
+    ('special_attack', 1, 'Mischievous'),
+    ('special_attack', 2, 'Thoroughly cunning'),
+    ('special_attack', 3, 'Often lost in thought'),
+    ('special_attack', 4, 'Very finicky'),
+    ('special_defense', 0, 'Strong willed'),
+    ('special_defense', 1, 'Somewhat vain'),
+    ('special_defense', 2, 'Strongly defiant'),
+    ('special_defense', 3, 'Hates to lose'),
+    ('special_defense', 4, 'Somewhat stubborn'),
+    ('speed', 0, 'Likes to run'),
+    ('speed', 1, 'Alert to sounds'),
+    ('speed', 2, 'Impetuous and silly'),
+    ('speed', 3, 'Somewhat of a clown'),
+    ('speed', 4, 'Quick to flee');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS characteristics;
+-- +goose StatementEnd
@@ -0,0 +1,62 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Cadenas evolutivas de PokeAPI; baby_trigger_item_id es el incienso que hace falta para criar la forma bebé
+CREATE TABLE evolution_chains (
+    id INTEGER PRIMARY KEY,
+    baby_trigger_item_id INTEGER REFERENCES items(id) ON DELETE SET NULL
+);
+
+ALTER TABLE pokemon_species
+    ADD COLUMN evolution_chain_id INTEGER REFERENCES evolution_chains(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_pokemon_species_evolution_chain_id ON pokemon_species(evolution_chain_id);
+
+-- level-up, trade, use-item, shed, ...
+CREATE TABLE evolution_triggers (
+    id INTEGER PRIMARY KEY,
+    name VARCHAR(100) UNIQUE NOT NULL
+);
+
+CREATE TABLE locations (
+    id INTEGER PRIMARY KEY,
+    name VARCHAR(100) UNIQUE NOT NULL,
+    region_id INTEGER
+);
+
+-- Cada fila es una forma de llegar a evolved_species_id desde la especie de la que evoluciona;
+-- todas las condiciones no nulas de la fila deben cumplirse a la vez
+CREATE TABLE pokemon_evolutions (
+    id INTEGER PRIMARY KEY,
+    evolved_species_id INTEGER NOT NULL REFERENCES pokemon_species(id) ON DELETE CASCADE,
+    evolution_trigger_id INTEGER NOT NULL REFERENCES evolution_triggers(id) ON DELETE CASCADE,
+    trigger_item_id INTEGER REFERENCES items(id) ON DELETE SET NULL,
+    minimum_level INTEGER,
+    gender VARCHAR(10) CHECK (gender IN ('female', 'male')),
+    location_id INTEGER REFERENCES locations(id) ON DELETE SET NULL,
+    held_item_id INTEGER REFERENCES items(id) ON DELETE SET NULL,
+    time_of_day VARCHAR(10),
+    known_move_id INTEGER REFERENCES moves(id) ON DELETE SET NULL,
+    known_move_type_id INTEGER REFERENCES types(id) ON DELETE SET NULL,
+    minimum_happiness INTEGER,
+    minimum_beauty INTEGER,
+    minimum_affection INTEGER,
+    -- 1: ataque > defensa, 0: iguales, -1: ataque < defensa (tyrogue)
+    relative_physical_stats INTEGER,
+    party_species_id INTEGER REFERENCES pokemon_species(id) ON DELETE SET NULL,
+    party_type_id INTEGER REFERENCES types(id) ON DELETE SET NULL,
+    trade_species_id INTEGER REFERENCES pokemon_species(id) ON DELETE SET NULL,
+    needs_overworld_rain BOOLEAN NOT NULL DEFAULT FALSE,
+    turn_upside_down BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE INDEX idx_pokemon_evolutions_evolved_species_id ON pokemon_evolutions(evolved_species_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS pokemon_evolutions;
+DROP TABLE IF EXISTS locations;
+DROP TABLE IF EXISTS evolution_triggers;
+ALTER TABLE pokemon_species DROP COLUMN IF EXISTS evolution_chain_id;
+DROP TABLE IF EXISTS evolution_chains;
+-- +goose StatementEnd
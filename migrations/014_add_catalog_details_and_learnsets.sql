@@ -0,0 +1,55 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Textos de efecto en inglés del volcado de PokeAPI, ya sin marcado
+ALTER TABLE moves
+    ADD COLUMN short_effect TEXT NOT NULL DEFAULT '',
+    ADD COLUMN effect TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE abilities
+    ADD COLUMN short_effect TEXT NOT NULL DEFAULT '',
+    ADD COLUMN effect TEXT NOT NULL DEFAULT '';
+
+-- holdable indica si el objeto puede llevarse equipado en combate
+ALTER TABLE items
+    ADD COLUMN category VARCHAR(100) NOT NULL DEFAULT '',
+    ADD COLUMN holdable BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN short_effect TEXT NOT NULL DEFAULT '',
+    ADD COLUMN effect TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX idx_items_holdable ON items(holdable);
+
+-- level-up, egg, tutor, machine, ...
+CREATE TABLE move_learn_methods (
+    id INTEGER PRIMARY KEY,
+    name VARCHAR(100) UNIQUE NOT NULL
+);
+
+-- Movimientos que aprende cada variedad en cada grupo de versiones; level es 0 fuera de level-up
+CREATE TABLE pokemon_moves (
+    pokemon_id INTEGER NOT NULL REFERENCES pokemon(id) ON DELETE CASCADE,
+    version_group_id INTEGER NOT NULL REFERENCES version_groups(id) ON DELETE CASCADE,
+    move_id INTEGER NOT NULL REFERENCES moves(id) ON DELETE CASCADE,
+    learn_method_id INTEGER NOT NULL REFERENCES move_learn_methods(id) ON DELETE CASCADE,
+    level INTEGER NOT NULL DEFAULT 0,
+    sort_order INTEGER,
+    PRIMARY KEY (pokemon_id, version_group_id, move_id, learn_method_id, level)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS pokemon_moves;
+DROP TABLE IF EXISTS move_learn_methods;
+DROP INDEX IF EXISTS idx_items_holdable;
+ALTER TABLE items
+    DROP COLUMN IF EXISTS effect,
+    DROP COLUMN IF EXISTS short_effect,
+    DROP COLUMN IF EXISTS holdable,
+    DROP COLUMN IF EXISTS category;
+ALTER TABLE abilities
+    DROP COLUMN IF EXISTS effect,
+    DROP COLUMN IF EXISTS short_effect;
+ALTER TABLE moves
+    DROP COLUMN IF EXISTS effect,
+    DROP COLUMN IF EXISTS short_effect;
+-- +goose StatementEnd
@@ -0,0 +1,43 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE egg_groups (
+    id INTEGER PRIMARY KEY,
+    name VARCHAR(100) UNIQUE NOT NULL
+);
+
+CREATE TABLE pokemon_egg_groups (
+    species_id INTEGER NOT NULL REFERENCES pokemon_species(id) ON DELETE CASCADE,
+    egg_group_id INTEGER NOT NULL REFERENCES egg_groups(id) ON DELETE CASCADE,
+    PRIMARY KEY (species_id, egg_group_id)
+);
+
+CREATE INDEX idx_pokemon_egg_groups_egg_group_id ON pokemon_egg_groups(egg_group_id);
+
+-- Índices para los filtros y ordenaciones de la búsqueda de Pokémon
+CREATE INDEX idx_pokemon_moves_move_id ON pokemon_moves(move_id);
+CREATE INDEX idx_pokemon_is_default ON pokemon(is_default);
+CREATE INDEX idx_pokemon_base_stats_hp ON pokemon_base_stats(hp);
+CREATE INDEX idx_pokemon_base_stats_attack ON pokemon_base_stats(attack);
+CREATE INDEX idx_pokemon_base_stats_defense ON pokemon_base_stats(defense);
+CREATE INDEX idx_pokemon_base_stats_special_attack ON pokemon_base_stats(special_attack);
+CREATE INDEX idx_pokemon_base_stats_special_defense ON pokemon_base_stats(special_defense);
+CREATE INDEX idx_pokemon_base_stats_speed ON pokemon_base_stats(speed);
+-- La expresión debe coincidir con la que usa el repositorio para bst
+CREATE INDEX idx_pokemon_base_stats_total
+    ON pokemon_base_stats((hp + attack + defense + special_attack + special_defense + speed));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_pokemon_base_stats_total;
+DROP INDEX IF EXISTS idx_pokemon_base_stats_speed;
+DROP INDEX IF EXISTS idx_pokemon_base_stats_special_defense;
+DROP INDEX IF EXISTS idx_pokemon_base_stats_special_attack;
+DROP INDEX IF EXISTS idx_pokemon_base_stats_defense;
+DROP INDEX IF EXISTS idx_pokemon_base_stats_attack;
+DROP INDEX IF EXISTS idx_pokemon_base_stats_hp;
+DROP INDEX IF EXISTS idx_pokemon_is_default;
+DROP INDEX IF EXISTS idx_pokemon_moves_move_id;
+DROP TABLE IF EXISTS pokemon_egg_groups;
+DROP TABLE IF EXISTS egg_groups;
+-- +goose StatementEnd
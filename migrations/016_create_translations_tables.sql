@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Nombres y descripciones traducidos; language es una etiqueta BCP 47 (en, es, fr, de, ja, ko, zh-Hans, zh-Hant)
+-- y flavor_text la descripción del juego más reciente que la tiene en ese idioma
+CREATE TABLE species_translations (
+    species_id INTEGER NOT NULL REFERENCES pokemon_species(id) ON DELETE CASCADE,
+    language VARCHAR(10) NOT NULL,
+    name VARCHAR(100) NOT NULL DEFAULT '',
+    genus VARCHAR(100) NOT NULL DEFAULT '',
+    flavor_text TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY (species_id, language)
+);
+
+CREATE TABLE move_translations (
+    move_id INTEGER NOT NULL REFERENCES moves(id) ON DELETE CASCADE,
+    language VARCHAR(10) NOT NULL,
+    name VARCHAR(100) NOT NULL DEFAULT '',
+    flavor_text TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY (move_id, language)
+);
+
+CREATE TABLE ability_translations (
+    ability_id INTEGER NOT NULL REFERENCES abilities(id) ON DELETE CASCADE,
+    language VARCHAR(10) NOT NULL,
+    name VARCHAR(100) NOT NULL DEFAULT '',
+    flavor_text TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY (ability_id, language)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS ability_translations;
+DROP TABLE IF EXISTS move_translations;
+DROP TABLE IF EXISTS species_translations;
+-- +goose StatementEnd
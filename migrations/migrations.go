@@ -0,0 +1,8 @@
+// Package migrations embeds the goose migrations, so every binary applies the
+// schema it was built with.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
@@ -0,0 +1,14 @@
+package auth
+
+import "go.uber.org/fx"
+
+func AuthProvider() fx.Option {
+	return fx.Options(
+		fx.Provide(
+			LoadKeySet,
+			NewRevocationList,
+			NewJWTService,
+			NewAuthMiddleware,
+		),
+	)
+}
@@ -1,104 +1,118 @@
 package auth
 
 import (
+	"context"
 	"errors"
 	"time"
 
+	"pokedex_backend_go/pkg/logger"
 	"pokedex_backend_go/pkg/model"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
 
-const jwtSecret = "your-super-secret-jwt-key-change-this-in-production"
+const issuer = "pokedex_backend_go"
+
+// AccessTokenTTL is kept short on purpose: long-lived sessions are handled by
+// rotating refresh tokens instead of long-lived access tokens.
+const AccessTokenTTL = 15 * time.Minute
+
+var ErrTokenRevoked = errors.New("token has been revoked")
 
 type Claims struct {
 	UserID string `json:"user_id"`
 	Email  string `json:"email"`
+	// IssuedAtMicro is iat in microseconds. iat only has second precision,
+	// which is too coarse to tell a token from a "log out all devices" done
+	// in the same second.
+	IssuedAtMicro int64 `json:"iat_us,omitempty"`
 	jwt.RegisteredClaims
 }
 
 type JWTService struct {
-	logger *zap.Logger
+	keys        *KeySet
+	revocations *RevocationList
 }
 
-func NewJWTService() *JWTService {
+func NewJWTService(keys *KeySet, revocations *RevocationList) *JWTService {
 	return &JWTService{
-		logger: zap.L().Named("jwt_service"),
+		keys:        keys,
+		revocations: revocations,
 	}
 }
 
-func (j *JWTService) GenerateToken(user *model.User) (string, error) {
+func (j *JWTService) GenerateToken(ctx context.Context, user *model.User) (string, error) {
+	now := time.Now()
 	claims := &Claims{
-		UserID: user.ID,
-		Email:  user.Email,
+		UserID:        user.ID,
+		Email:         user.Email,
+		IssuedAtMicro: now.UnixMicro(),
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(72 * time.Hour)),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			NotBefore: jwt.NewNumericDate(time.Now()),
-			Issuer:    "pokedex_backend_go",
+			ExpiresAt: jwt.NewNumericDate(now.Add(AccessTokenTTL)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			Issuer:    issuer,
 			Subject:   user.ID,
+			ID:        uuid.NewString(),
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	signing := j.keys.Signing()
 
-	tokenString, err := token.SignedString([]byte(jwtSecret))
+	token := jwt.NewWithClaims(signing.Method, claims)
+	token.Header["kid"] = signing.ID
+
+	tokenString, err := token.SignedString(signing.privateKey)
 	if err != nil {
-		j.logger.Error("Failed to sign JWT token", zap.Error(err))
+		logger.FromContext(ctx).Error("Failed to sign JWT token", zap.Error(err))
 		return "", err
 	}
 
-	j.logger.Info("JWT token generated successfully", zap.String("user_id", user.ID), zap.String("email", user.Email))
+	logger.FromContext(ctx).Info("JWT token generated successfully", zap.String("user_id", user.ID), zap.String("email", user.Email))
 	return tokenString, nil
 }
 
-func (j *JWTService) ValidateToken(tokenString string) (*Claims, error) {
+func (j *JWTService) JWKS() *JWKS {
+	return j.keys.JWKS()
+}
+
+func (j *JWTService) ValidateToken(ctx context.Context, tokenString string) (*Claims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+		kid, _ := token.Header["kid"].(string)
+		key, ok := j.keys.Lookup(kid)
+		if !ok {
+			return nil, errors.New("unknown signing key")
+		}
+
+		if token.Method.Alg() != key.Method.Alg() {
 			return nil, errors.New("unexpected signing method")
 		}
-		return []byte(jwtSecret), nil
-	})
+
+		return key.PublicKey, nil
+	}, jwt.WithValidMethods(j.keys.Algorithms()), jwt.WithIssuer(issuer))
 	if err != nil {
-		j.logger.Error("Failed to parse JWT token", zap.Error(err))
+		logger.FromContext(ctx).Error("Failed to parse JWT token", zap.Error(err))
 		return nil, err
 	}
 
 	if claims, ok := token.Claims.(*Claims); ok && token.Valid {
-		j.logger.Debug("JWT token validated successfully", zap.String("user_id", claims.UserID))
-		return claims, nil
-	}
-
-	j.logger.Error("Invalid JWT token")
-	return nil, errors.New("invalid token")
-}
-
-func (j *JWTService) RefreshToken(tokenString string) (string, error) {
-	claims, err := j.ValidateToken(tokenString)
-	if err != nil {
-		return "", err
-	}
+		revoked, err := j.revocations.IsRevoked(ctx, claims)
+		if err != nil {
+			logger.FromContext(ctx).Error("Failed to check JWT token revocation", zap.Error(err))
+			return nil, err
+		}
 
-	newClaims := &Claims{
-		UserID: claims.UserID,
-		Email:  claims.Email,
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(24 * time.Hour)),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			NotBefore: jwt.NewNumericDate(time.Now()),
-			Issuer:    "pokedex_backend_go",
-			Subject:   claims.UserID,
-		},
-	}
+		if revoked {
+			logger.FromContext(ctx).Warn("Revoked JWT token used", zap.String("user_id", claims.UserID), zap.String("jti", claims.ID))
+			return nil, ErrTokenRevoked
+		}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, newClaims)
-	tokenString, err = token.SignedString([]byte(jwtSecret))
-	if err != nil {
-		j.logger.Error("Failed to refresh JWT token", zap.Error(err))
-		return "", err
+		logger.FromContext(ctx).Debug("JWT token validated successfully", zap.String("user_id", claims.UserID))
+		return claims, nil
 	}
 
-	j.logger.Info("JWT token refreshed successfully", zap.String("user_id", claims.UserID))
-	return tokenString, nil
+	logger.FromContext(ctx).Error("Invalid JWT token")
+	return nil, errors.New("invalid token")
 }
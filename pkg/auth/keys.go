@@ -0,0 +1,304 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+
+	"pokedex_backend_go/pkg/config"
+
+	"github.com/golang-jwt/jwt/v5"
+	"go.uber.org/zap"
+)
+
+const minRSAKeyBits = 2048
+
+// KeyConfig describes where the JWT keys come from. Keys can be given inline
+// as PEM or as a path to a PEM file. The signing key is always accepted for
+// verification; VerificationKeys holds the keys being rotated out, which are
+// still accepted until the tokens they signed have expired.
+type KeyConfig struct {
+	SigningKeyID     string
+	SigningKey       string
+	SigningKeyFile   string
+	VerificationKeys []VerificationKeyConfig
+	// AllowEphemeralKey generates a throwaway Ed25519 signing key when none
+	// is configured. Its tokens do not survive a restart and are not
+	// accepted by other replicas, so it is only meant for development.
+	AllowEphemeralKey bool
+}
+
+type VerificationKeyConfig struct {
+	ID   string
+	Key  string
+	File string
+}
+
+type Key struct {
+	ID         string
+	Method     jwt.SigningMethod
+	PublicKey  crypto.PublicKey
+	privateKey crypto.Signer
+}
+
+// KeySet holds the key used to sign new tokens and every key accepted when
+// verifying them, indexed by kid.
+type KeySet struct {
+	signing *Key
+	keys    map[string]*Key
+}
+
+func LoadKeySet(cfg *config.Config) (*KeySet, error) {
+	keyConfig := KeyConfig{
+		SigningKeyID:      cfg.JWT.SigningKeyID,
+		SigningKey:        cfg.JWT.SigningKey,
+		SigningKeyFile:    cfg.JWT.SigningKeyFile,
+		AllowEphemeralKey: cfg.JWT.AllowEphemeralKey,
+	}
+
+	for _, key := range cfg.JWT.VerificationKeys {
+		keyConfig.VerificationKeys = append(keyConfig.VerificationKeys, VerificationKeyConfig{
+			ID:   key.ID,
+			Key:  key.Key,
+			File: key.File,
+		})
+	}
+
+	return NewKeySet(keyConfig)
+}
+
+func NewKeySet(cfg KeyConfig) (*KeySet, error) {
+	logger := zap.L().Named("jwt_keys")
+
+	set := &KeySet{keys: make(map[string]*Key)}
+
+	signing, err := signingKey(cfg, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	set.signing = signing
+	set.keys[signing.ID] = signing
+
+	for _, verification := range cfg.VerificationKeys {
+		if _, exists := set.keys[verification.ID]; exists {
+			return nil, fmt.Errorf("duplicate JWT key id %q", verification.ID)
+		}
+
+		data, err := readPEM(verification.Key, verification.File)
+		if err != nil {
+			return nil, fmt.Errorf("verification key %q: %w", verification.ID, err)
+		}
+
+		parsed, err := parseKey(data)
+		if err != nil {
+			return nil, fmt.Errorf("verification key %q: %w", verification.ID, err)
+		}
+
+		key, err := newKey(verification.ID, parsed)
+		if err != nil {
+			return nil, fmt.Errorf("verification key %q: %w", verification.ID, err)
+		}
+
+		// Only the configured signing key may sign; old keys are kept for verification.
+		key.privateKey = nil
+		set.keys[key.ID] = key
+	}
+
+	logger.Info("JWT keys loaded", zap.String("signing_kid", set.signing.ID), zap.Int("keys", len(set.keys)))
+	return set, nil
+}
+
+// signingKey loads the configured signing key, or generates an ephemeral
+// one when none is configured and AllowEphemeralKey is set.
+func signingKey(cfg KeyConfig, logger *zap.Logger) (*Key, error) {
+	if cfg.SigningKey == "" && cfg.SigningKeyFile == "" {
+		if !cfg.AllowEphemeralKey {
+			return nil, errors.New("no JWT signing key configured")
+		}
+
+		logger.Warn("No JWT signing key configured, using an ephemeral Ed25519 key; tokens will not survive a restart")
+
+		_, private, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+
+		kid := cfg.SigningKeyID
+		if kid == "" {
+			kid = "ephemeral"
+		}
+
+		return newKey(kid, private)
+	}
+
+	if cfg.SigningKeyID == "" {
+		return nil, errors.New("a kid is required for the JWT signing key")
+	}
+
+	data, err := readPEM(cfg.SigningKey, cfg.SigningKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("signing key %q: %w", cfg.SigningKeyID, err)
+	}
+
+	parsed, err := parseKey(data)
+	if err != nil {
+		return nil, fmt.Errorf("signing key %q: %w", cfg.SigningKeyID, err)
+	}
+
+	private, ok := parsed.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("signing key %q: a private key is required", cfg.SigningKeyID)
+	}
+
+	key, err := newKey(cfg.SigningKeyID, private)
+	if err != nil {
+		return nil, fmt.Errorf("signing key %q: %w", cfg.SigningKeyID, err)
+	}
+
+	return key, nil
+}
+
+func (s *KeySet) Signing() *Key {
+	return s.signing
+}
+
+func (s *KeySet) Lookup(kid string) (*Key, bool) {
+	key, ok := s.keys[kid]
+	return key, ok
+}
+
+// Algorithms lists the JWT algorithms of every known key, used to reject
+// tokens signed with any other algorithm before looking at the kid.
+func (s *KeySet) Algorithms() []string {
+	seen := make(map[string]bool)
+	var algs []string
+	for _, key := range s.keys {
+		alg := key.Method.Alg()
+		if !seen[alg] {
+			seen[alg] = true
+			algs = append(algs, alg)
+		}
+	}
+
+	sort.Strings(algs)
+	return algs
+}
+
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWKS returns the public part of every verification key in the JSON Web Key
+// Set format (RFC 7517) so other services can verify our tokens.
+func (s *KeySet) JWKS() *JWKS {
+	jwks := &JWKS{Keys: make([]JWK, 0, len(s.keys))}
+
+	for _, key := range s.keys {
+		jwk := JWK{
+			Kid: key.ID,
+			Use: "sig",
+			Alg: key.Method.Alg(),
+		}
+
+		switch public := key.PublicKey.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(public.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(public)
+		}
+
+		jwks.Keys = append(jwks.Keys, jwk)
+	}
+
+	sort.Slice(jwks.Keys, func(i, j int) bool {
+		return jwks.Keys[i].Kid < jwks.Keys[j].Kid
+	})
+
+	return jwks
+}
+
+func newKey(kid string, parsed any) (*Key, error) {
+	key := &Key{ID: kid}
+
+	switch k := parsed.(type) {
+	case *rsa.PrivateKey:
+		key.privateKey = k
+		key.PublicKey = &k.PublicKey
+	case ed25519.PrivateKey:
+		key.privateKey = k
+		key.PublicKey = k.Public()
+	case *rsa.PublicKey, ed25519.PublicKey:
+		key.PublicKey = k
+	default:
+		return nil, fmt.Errorf("unsupported key type %T, only RSA and Ed25519 keys are supported", parsed)
+	}
+
+	switch public := key.PublicKey.(type) {
+	case *rsa.PublicKey:
+		if public.N.BitLen() < minRSAKeyBits {
+			return nil, fmt.Errorf("RSA key must be at least %d bits", minRSAKeyBits)
+		}
+		key.Method = jwt.SigningMethodRS256
+	case ed25519.PublicKey:
+		key.Method = jwt.SigningMethodEdDSA
+	}
+
+	return key, nil
+}
+
+func readPEM(inline, file string) ([]byte, error) {
+	if inline != "" {
+		return []byte(inline), nil
+	}
+
+	if file == "" {
+		return nil, errors.New("no key or key file given")
+	}
+
+	return os.ReadFile(file)
+}
+
+func parseKey(data []byte) (any, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	switch block.Type {
+	case "PRIVATE KEY":
+		return x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		return x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		return x509.ParsePKCS1PublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type %q", block.Type)
+	}
+}
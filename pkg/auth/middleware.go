@@ -5,6 +5,9 @@ import (
 	"net/http"
 	"strings"
 
+	"pokedex_backend_go/pkg/logger"
+	"pokedex_backend_go/pkg/problem"
+
 	"go.uber.org/zap"
 )
 
@@ -14,15 +17,19 @@ const (
 	UserContextKey ContextKey = "user"
 )
 
+var (
+	ErrAuthorizationRequired = problem.New(http.StatusUnauthorized, "authorization_required", "Authorization header required")
+	ErrInvalidAuthorization  = problem.New(http.StatusUnauthorized, "invalid_authorization_header", "Invalid Authorization header format")
+	ErrInvalidToken          = problem.New(http.StatusUnauthorized, "invalid_token", "Invalid token")
+)
+
 type AuthMiddleware struct {
 	jwtService *JWTService
-	logger     *zap.Logger
 }
 
 func NewAuthMiddleware(jwtService *JWTService) *AuthMiddleware {
 	return &AuthMiddleware{
 		jwtService: jwtService,
-		logger:     zap.L().Named("auth_middleware"),
 	}
 }
 
@@ -30,31 +37,32 @@ func (a *AuthMiddleware) RequireAuth(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		authHeader := r.Header.Get("Authorization")
 		if authHeader == "" {
-			a.logger.Warn("Missing Authorization header")
-			http.Error(w, "Authorization header required", http.StatusUnauthorized)
+			logger.FromContext(r.Context()).Warn("Missing Authorization header")
+			problem.Write(w, r, ErrAuthorizationRequired)
 			return
 		}
 
 		parts := strings.SplitN(authHeader, " ", 2)
 		if len(parts) != 2 || parts[0] != "Bearer" {
-			a.logger.Warn("Invalid Authorization header format")
-			http.Error(w, "Invalid Authorization header format", http.StatusUnauthorized)
+			logger.FromContext(r.Context()).Warn("Invalid Authorization header format")
+			problem.Write(w, r, ErrInvalidAuthorization)
 			return
 		}
 
 		tokenString := parts[1]
 
-		claims, err := a.jwtService.ValidateToken(tokenString)
+		claims, err := a.jwtService.ValidateToken(r.Context(), tokenString)
 		if err != nil {
-			a.logger.Warn("Invalid JWT token", zap.Error(err))
-			http.Error(w, "Invalid token", http.StatusUnauthorized)
+			logger.FromContext(r.Context()).Warn("Invalid JWT token", zap.Error(err))
+			problem.Write(w, r, ErrInvalidToken)
 			return
 		}
 
 		ctx := context.WithValue(r.Context(), UserContextKey, claims)
 		r = r.WithContext(ctx)
 
-		a.logger.Debug("User authenticated successfully", zap.String("user_id", claims.UserID))
+		logger.With(ctx, zap.String("user_id", claims.UserID))
+		logger.FromContext(ctx).Debug("User authenticated successfully")
 
 		next.ServeHTTP(w, r)
 	})
@@ -69,13 +77,14 @@ func (a *AuthMiddleware) OptionalAuth(next http.Handler) http.Handler {
 			if len(parts) == 2 && parts[0] == "Bearer" {
 				tokenString := parts[1]
 
-				claims, err := a.jwtService.ValidateToken(tokenString)
+				claims, err := a.jwtService.ValidateToken(r.Context(), tokenString)
 				if err == nil {
 					ctx := context.WithValue(r.Context(), UserContextKey, claims)
 					r = r.WithContext(ctx)
-					a.logger.Debug("User authenticated successfully (optional)", zap.String("user_id", claims.UserID))
+					logger.With(ctx, zap.String("user_id", claims.UserID))
+					logger.FromContext(ctx).Debug("User authenticated successfully (optional)")
 				} else {
-					a.logger.Debug("Invalid token in optional auth", zap.Error(err))
+					logger.FromContext(r.Context()).Debug("Invalid token in optional auth", zap.Error(err))
 				}
 			}
 		}
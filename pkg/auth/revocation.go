@@ -0,0 +1,223 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"pokedex_backend_go/pkg/database"
+	"pokedex_backend_go/pkg/logger"
+	"pokedex_backend_go/pkg/model"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// revocationCacheTTL bounds how long a "not revoked" answer is trusted before
+// asking Postgres again, i.e. how long a logout done on another instance can
+// take to be seen here.
+const revocationCacheTTL = 30 * time.Second
+
+type cachedToken struct {
+	revoked     bool
+	cachedUntil time.Time
+}
+
+type cachedUser struct {
+	revokedBefore time.Time
+	cachedUntil   time.Time
+}
+
+// RevocationList keeps track of access tokens that were revoked before their
+// expiration. Revocations are stored in Postgres and cached in memory so that
+// validating a token does not hit the database on every request.
+type RevocationList struct {
+	mu        sync.RWMutex
+	tokens    map[string]cachedToken
+	users     map[string]cachedUser
+	lastPrune time.Time
+}
+
+func NewRevocationList() *RevocationList {
+	return &RevocationList{
+		tokens:    make(map[string]cachedToken),
+		users:     make(map[string]cachedUser),
+		lastPrune: time.Now(),
+	}
+}
+
+// Revoke invalidates a single access token until it expires.
+func (l *RevocationList) Revoke(ctx context.Context, claims *Claims) error {
+	if claims.ID == "" {
+		return errors.New("token has no jti")
+	}
+
+	expiresAt := time.Now().Add(AccessTokenTTL)
+	if claims.ExpiresAt != nil {
+		expiresAt = claims.ExpiresAt.Time
+	}
+
+	orm := database.Orm(ctx)
+
+	revoked := &model.RevokedToken{
+		JTI:       claims.ID,
+		UserID:    claims.UserID,
+		ExpiresAt: expiresAt,
+	}
+
+	result := orm.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(revoked)
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to revoke token", zap.String("user_id", claims.UserID), zap.Error(result.Error))
+		return result.Error
+	}
+
+	result = orm.WithContext(ctx).Where("expires_at < ?", time.Now()).Delete(&model.RevokedToken{})
+	if result.Error != nil {
+		logger.FromContext(ctx).Warn("Failed to purge expired revoked tokens", zap.Error(result.Error))
+	}
+
+	l.mu.Lock()
+	l.tokens[claims.ID] = cachedToken{revoked: true, cachedUntil: expiresAt}
+	l.mu.Unlock()
+
+	logger.FromContext(ctx).Info("Token revoked", zap.String("user_id", claims.UserID), zap.String("jti", claims.ID))
+	return nil
+}
+
+// RevokeAll invalidates every access token issued to the user up to now.
+func (l *RevocationList) RevokeAll(ctx context.Context, userID string) error {
+	// Postgres keeps microseconds, so the cache has to agree with what is
+	// read back from the database.
+	now := time.Now().Truncate(time.Microsecond)
+	orm := database.Orm(ctx)
+
+	revocation := &model.UserTokenRevocation{
+		UserID:        userID,
+		RevokedBefore: now,
+	}
+
+	result := orm.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"revoked_before", "updated_at"}),
+	}).Create(revocation)
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("Failed to revoke user tokens", zap.String("user_id", userID), zap.Error(result.Error))
+		return result.Error
+	}
+
+	l.mu.Lock()
+	l.users[userID] = cachedUser{revokedBefore: now, cachedUntil: now.Add(revocationCacheTTL)}
+	l.mu.Unlock()
+
+	logger.FromContext(ctx).Info("All user tokens revoked", zap.String("user_id", userID))
+	return nil
+}
+
+func (l *RevocationList) IsRevoked(ctx context.Context, claims *Claims) (bool, error) {
+	revokedBefore, err := l.userRevokedBefore(ctx, claims.UserID)
+	if err != nil {
+		return false, err
+	}
+
+	if !revokedBefore.IsZero() && issuedBefore(claims, revokedBefore) {
+		return true, nil
+	}
+
+	if claims.ID == "" {
+		return false, nil
+	}
+
+	return l.tokenRevoked(ctx, claims.ID)
+}
+
+// issuedBefore reports whether the token was issued no later than t. Tokens
+// without iat_us only have the second-precision iat, so one issued in the
+// same second as t is treated as issued before it.
+func issuedBefore(claims *Claims, t time.Time) bool {
+	if claims.IssuedAtMicro != 0 {
+		return claims.IssuedAtMicro <= t.UnixMicro()
+	}
+
+	return claims.IssuedAt != nil && !claims.IssuedAt.Time.After(t)
+}
+
+func (l *RevocationList) tokenRevoked(ctx context.Context, jti string) (bool, error) {
+	now := time.Now()
+
+	l.mu.RLock()
+	entry, ok := l.tokens[jti]
+	l.mu.RUnlock()
+	if ok && now.Before(entry.cachedUntil) {
+		return entry.revoked, nil
+	}
+
+	orm := database.Orm(ctx)
+
+	var revoked model.RevokedToken
+	result := orm.WithContext(ctx).Where("jti = ?", jti).First(&revoked)
+	if result.Error != nil && !errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		logger.FromContext(ctx).Error("Failed to look up revoked token", zap.Error(result.Error))
+		return false, result.Error
+	}
+
+	entry = cachedToken{revoked: false, cachedUntil: now.Add(revocationCacheTTL)}
+	if result.Error == nil {
+		entry = cachedToken{revoked: true, cachedUntil: revoked.ExpiresAt}
+	}
+
+	l.mu.Lock()
+	l.tokens[jti] = entry
+	l.pruneLocked(now)
+	l.mu.Unlock()
+
+	return entry.revoked, nil
+}
+
+func (l *RevocationList) userRevokedBefore(ctx context.Context, userID string) (time.Time, error) {
+	now := time.Now()
+
+	l.mu.RLock()
+	entry, ok := l.users[userID]
+	l.mu.RUnlock()
+	if ok && now.Before(entry.cachedUntil) {
+		return entry.revokedBefore, nil
+	}
+
+	orm := database.Orm(ctx)
+
+	var revocation model.UserTokenRevocation
+	result := orm.WithContext(ctx).Where("user_id = ?", userID).First(&revocation)
+	if result.Error != nil && !errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		logger.FromContext(ctx).Error("Failed to look up user token revocation", zap.String("user_id", userID), zap.Error(result.Error))
+		return time.Time{}, result.Error
+	}
+
+	l.mu.Lock()
+	l.users[userID] = cachedUser{revokedBefore: revocation.RevokedBefore, cachedUntil: now.Add(revocationCacheTTL)}
+	l.pruneLocked(now)
+	l.mu.Unlock()
+
+	return revocation.RevokedBefore, nil
+}
+
+func (l *RevocationList) pruneLocked(now time.Time) {
+	if now.Sub(l.lastPrune) < time.Minute {
+		return
+	}
+
+	for jti, entry := range l.tokens {
+		if now.After(entry.cachedUntil) {
+			delete(l.tokens, jti)
+		}
+	}
+
+	for userID, entry := range l.users {
+		if now.After(entry.cachedUntil) {
+			delete(l.users, userID)
+		}
+	}
+
+	l.lastPrune = now
+}
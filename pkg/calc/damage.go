@@ -0,0 +1,335 @@
+package calc
+
+import (
+	"errors"
+
+	"pokedex_backend_go/pkg/model"
+	"pokedex_backend_go/pkg/typechart"
+)
+
+const (
+	WeatherSun  = "sun"
+	WeatherRain = "rain"
+	WeatherSand = "sand"
+	WeatherSnow = "snow"
+
+	TerrainElectric = "electric"
+	TerrainGrassy   = "grassy"
+	TerrainPsychic  = "psychic"
+	TerrainMisty    = "misty"
+
+	// MinStage and MaxStage bound stat stages.
+	MinStage = -6
+	MaxStage = 6
+)
+
+// Rolls is the number of random damage rolls, 85% to 100%.
+const Rolls = 16
+
+var ErrStatusMove = errors.New("status moves deal no damage")
+
+// Modifiers are expressed in 4096ths, like the games do.
+const (
+	modifierBase        = 4096
+	modifierHalf        = 2048
+	modifierSpread      = 3072
+	modifierOneAndHalf  = 6144
+	modifierDouble      = 8192
+	modifierTerrain     = 5325
+	modifierLifeOrb     = 5324
+	modifierExpertBelt  = 4915
+	modifierTintedLens  = 8192
+	modifierSolidFilter = 3072
+)
+
+// Battler is one side of the calculation. Stats are the final stats before
+// stages, e.g. as returned by Stats; Boosts holds the stat stages, -6 to +6,
+// of which HP is ignored. Statused is set for any major status condition,
+// Burned additionally for a burn. Only the attacker's Level is used and
+// CurrentHP defaults to the defender's full HP.
+type Battler struct {
+	Level     int
+	Types     []string
+	Stats     model.StatSpread
+	Boosts    model.StatSpread
+	Ability   string
+	Item      string
+	Burned    bool
+	Statused  bool
+	CurrentHP int
+}
+
+type Move struct {
+	Type        string
+	Power       int
+	DamageClass string
+}
+
+// Field holds the battle conditions. Spread is set for moves hitting more
+// than one target, which deal 75% damage.
+type Field struct {
+	Weather  string
+	Terrain  string
+	Spread   bool
+	Critical bool
+}
+
+type DamageInput struct {
+	Attacker Battler
+	Defender Battler
+	Move     Move
+	Field    Field
+	Chart    *typechart.Chart
+}
+
+// KOChance is the probability of knocking the defender out in Hits hits.
+type KOChance struct {
+	Hits   int
+	Chance float64
+}
+
+type DamageResult struct {
+	Rolls         []int
+	Min           int
+	Max           int
+	MinPercent    float64
+	MaxPercent    float64
+	Effectiveness float64
+	KO            KOChance
+}
+
+// Damage runs the damage formula of generation 9:
+//
+//	base = floor(floor(floor(2*Level/5 + 2) * Power * A / D) / 50) + 2
+//
+// followed, in this order, by the spread, weather, critical hit, random
+// roll, STAB, type effectiveness, burn and final (items, abilities)
+// modifiers, rounding after each step as the games do.
+func Damage(in DamageInput) (*DamageResult, error) {
+	if in.Move.DamageClass == model.DamageClassStatus {
+		return nil, ErrStatusMove
+	}
+
+	attacker, defender, move, field := in.Attacker, in.Defender, in.Move, in.Field
+
+	effectiveness, err := in.Chart.Effectiveness(move.Type, defender.Types...)
+	if err != nil {
+		return nil, err
+	}
+	if move.Type == "ground" && defender.Ability == "levitate" {
+		effectiveness = 0
+	}
+
+	result := &DamageResult{Effectiveness: effectiveness, Rolls: make([]int, Rolls)}
+	if effectiveness == 0 {
+		return result, nil
+	}
+
+	power := basePower(attacker, defender, move, field)
+	attack, defense := attackStats(attacker, defender, move, field)
+
+	base := (2*attacker.Level/5+2)*power*attack/defense/50 + 2
+
+	if field.Spread {
+		base = applyModifier(base, modifierSpread)
+	}
+	switch {
+	case field.Weather == WeatherSun && move.Type == "fire",
+		field.Weather == WeatherRain && move.Type == "water":
+		base = applyModifier(base, modifierOneAndHalf)
+	case field.Weather == WeatherSun && move.Type == "water",
+		field.Weather == WeatherRain && move.Type == "fire":
+		base = applyModifier(base, modifierHalf)
+	}
+	if field.Critical {
+		base = base * 3 / 2
+	}
+
+	for i := 0; i < Rolls; i++ {
+		damage := base * (85 + i) / 100
+
+		if hasType(attacker.Types, move.Type) {
+			if attacker.Ability == "adaptability" {
+				damage = applyModifier(damage, modifierDouble)
+			} else {
+				damage = applyModifier(damage, modifierOneAndHalf)
+			}
+		}
+
+		damage = int(float64(damage) * effectiveness)
+
+		if attacker.Burned && move.DamageClass == model.DamageClassPhysical && attacker.Ability != "guts" {
+			damage /= 2
+		}
+
+		damage = applyModifier(damage, finalModifier(attacker, defender, effectiveness))
+
+		if damage < 1 {
+			damage = 1
+		}
+		result.Rolls[i] = damage
+	}
+
+	result.Min, result.Max = result.Rolls[0], result.Rolls[Rolls-1]
+
+	hp := defender.CurrentHP
+	if hp <= 0 {
+		hp = defender.Stats.HP
+	}
+	if defender.Stats.HP > 0 {
+		result.MinPercent = percent(result.Min, defender.Stats.HP)
+		result.MaxPercent = percent(result.Max, defender.Stats.HP)
+	}
+	result.KO = koChance(result.Rolls, hp)
+
+	return result, nil
+}
+
+func basePower(attacker, defender Battler, move Move, field Field) int {
+	power := move.Power
+
+	if attacker.Ability == "technician" && power <= 60 {
+		power = applyModifier(power, modifierOneAndHalf)
+	}
+
+	switch {
+	case field.Terrain == TerrainElectric && move.Type == "electric" && grounded(attacker),
+		field.Terrain == TerrainGrassy && move.Type == "grass" && grounded(attacker),
+		field.Terrain == TerrainPsychic && move.Type == "psychic" && grounded(attacker):
+		power = applyModifier(power, modifierTerrain)
+	case field.Terrain == TerrainMisty && move.Type == "dragon" && grounded(defender):
+		power = applyModifier(power, modifierHalf)
+	}
+
+	return power
+}
+
+// attackStats returns the attacking and defending stat with stages, items,
+// abilities and weather applied. Critical hits ignore the attacker's
+// negative stages and the defender's positive ones.
+func attackStats(attacker, defender Battler, move Move, field Field) (int, int) {
+	attackName, defenseName := "attack", "defense"
+	if move.DamageClass == model.DamageClassSpecial {
+		attackName, defenseName = "special_attack", "special_defense"
+	}
+
+	attackStage := *attacker.Boosts.Field(attackName)
+	defenseStage := *defender.Boosts.Field(defenseName)
+	if field.Critical {
+		attackStage = max(attackStage, 0)
+		defenseStage = min(defenseStage, 0)
+	}
+
+	attack := applyStage(*attacker.Stats.Field(attackName), attackStage)
+	defense := applyStage(*defender.Stats.Field(defenseName), defenseStage)
+
+	if move.DamageClass == model.DamageClassPhysical {
+		if attacker.Ability == "huge-power" || attacker.Ability == "pure-power" {
+			attack *= 2
+		}
+		if attacker.Ability == "guts" && (attacker.Statused || attacker.Burned) {
+			attack = applyModifier(attack, modifierOneAndHalf)
+		}
+		if attacker.Item == "choice-band" {
+			attack = applyModifier(attack, modifierOneAndHalf)
+		}
+		if field.Weather == WeatherSnow && hasType(defender.Types, "ice") {
+			defense = applyModifier(defense, modifierOneAndHalf)
+		}
+	} else {
+		if attacker.Item == "choice-specs" {
+			attack = applyModifier(attack, modifierOneAndHalf)
+		}
+		if field.Weather == WeatherSand && hasType(defender.Types, "rock") {
+			defense = applyModifier(defense, modifierOneAndHalf)
+		}
+	}
+
+	return max(attack, 1), max(defense, 1)
+}
+
+func finalModifier(attacker, defender Battler, effectiveness float64) int {
+	modifier := modifierBase
+
+	if attacker.Item == "life-orb" {
+		modifier = chain(modifier, modifierLifeOrb)
+	}
+	if attacker.Item == "expert-belt" && effectiveness > 1 {
+		modifier = chain(modifier, modifierExpertBelt)
+	}
+	if attacker.Ability == "tinted-lens" && effectiveness < 1 {
+		modifier = chain(modifier, modifierTintedLens)
+	}
+	if (defender.Ability == "filter" || defender.Ability == "solid-rock" || defender.Ability == "prism-armor") && effectiveness > 1 {
+		modifier = chain(modifier, modifierSolidFilter)
+	}
+
+	return modifier
+}
+
+// applyModifier multiplies value by modifier/4096, rounding halves down.
+func applyModifier(value, modifier int) int {
+	return (value*modifier + modifierHalf - 1) / modifierBase
+}
+
+// chain combines two modifiers the way the games do.
+func chain(a, b int) int {
+	return (a*b + modifierHalf) / modifierBase
+}
+
+func applyStage(stat, stage int) int {
+	stage = max(MinStage, min(MaxStage, stage))
+	if stage >= 0 {
+		return stat * (2 + stage) / 2
+	}
+
+	return stat * 2 / (2 - stage)
+}
+
+func grounded(b Battler) bool {
+	return !hasType(b.Types, "flying") && b.Ability != "levitate"
+}
+
+func hasType(types []string, t string) bool {
+	for _, candidate := range types {
+		if candidate == t {
+			return true
+		}
+	}
+
+	return false
+}
+
+func percent(damage, hp int) float64 {
+	return float64(int(float64(damage)*1000/float64(hp))) / 10
+}
+
+// maxHits bounds the search for the number of hits needed to KO.
+const maxHits = 8
+
+// koChance returns the fewest hits that can KO and the probability they do,
+// assuming every hit rolls independently.
+func koChance(rolls []int, hp int) KOChance {
+	if rolls[Rolls-1] <= 0 || hp <= 0 {
+		return KOChance{}
+	}
+
+	// distribution[d] is the probability that the hits so far dealt d damage,
+	// capped at hp.
+	distribution := map[int]float64{0: 1}
+	for hits := 1; hits <= maxHits; hits++ {
+		next := make(map[int]float64)
+		for dealt, p := range distribution {
+			for _, roll := range rolls {
+				next[min(dealt+roll, hp)] += p / Rolls
+			}
+		}
+		distribution = next
+
+		if chance := distribution[hp]; chance > 0 {
+			return KOChance{Hits: hits, Chance: float64(int(chance*10000)) / 10000}
+		}
+	}
+
+	return KOChance{}
+}
@@ -0,0 +1,203 @@
+package calc
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"pokedex_backend_go/pkg/model"
+	"pokedex_backend_go/pkg/typechart"
+)
+
+// The expected rolls are those Showdown computes for the same stats, e.g.
+// 252+ Atk Garchomp Earthquake vs. 252 HP / 0 Def Heatran: 684-808. Any
+// rounding step done out of order shows up here.
+func TestDamage(t *testing.T) {
+	tests := []struct {
+		name     string
+		attacker Battler
+		defender Battler
+		move     Move
+		field    Field
+		rolls    []int
+		percent  [2]float64
+		ko       KOChance
+	}{
+		{
+			name:     "STAB, 4x effective",
+			attacker: Battler{Level: 100, Types: []string{"dragon", "ground"}, Stats: model.StatSpread{Attack: 394, SpecialAttack: 176}},
+			defender: Battler{Types: []string{"fire", "steel"}, Stats: model.StatSpread{HP: 386, Defense: 248, SpecialDefense: 248}},
+			move:     Move{Type: "ground", Power: 100, DamageClass: model.DamageClassPhysical},
+			rolls:    []int{684, 696, 700, 708, 720, 724, 732, 744, 748, 756, 768, 772, 780, 792, 796, 808},
+			percent:  [2]float64{177.2, 209.3},
+			ko:       KOChance{Hits: 1, Chance: 1},
+		},
+		{
+			name:     "Adaptability STAB",
+			attacker: Battler{Level: 100, Types: []string{"normal"}, Stats: model.StatSpread{Attack: 196, SpecialAttack: 369}, Ability: "adaptability"},
+			defender: Battler{Types: []string{"water"}, Stats: model.StatSpread{HP: 464, Defense: 156, SpecialDefense: 226}},
+			move:     Move{Type: "normal", Power: 80, DamageClass: model.DamageClassSpecial},
+			rolls:    []int{188, 190, 192, 194, 196, 198, 202, 204, 206, 208, 210, 212, 214, 216, 218, 222},
+			percent:  [2]float64{40.5, 47.8},
+			ko:       KOChance{Hits: 3, Chance: 1},
+		},
+		{
+			name:     "spread move, 2HKO chance",
+			attacker: Battler{Level: 50, Types: []string{"dragon", "ground"}, Stats: model.StatSpread{Attack: 200, SpecialAttack: 100}},
+			defender: Battler{Types: []string{"normal"}, Stats: model.StatSpread{HP: 235, Defense: 85, SpecialDefense: 130}},
+			move:     Move{Type: "ground", Power: 100, DamageClass: model.DamageClassPhysical},
+			field:    Field{Spread: true},
+			rolls:    []int{100, 100, 102, 103, 105, 106, 106, 108, 109, 111, 112, 112, 114, 115, 117, 118},
+			percent:  [2]float64{42.5, 50.2},
+			ko:       KOChance{Hits: 2, Chance: 0.0117},
+		},
+		{
+			name:     "critical hit keeps attack boost, ignores defense boost",
+			attacker: Battler{Level: 100, Types: []string{"fighting", "steel"}, Stats: model.StatSpread{Attack: 328, SpecialAttack: 266}, Boosts: model.StatSpread{Attack: 2}},
+			defender: Battler{Types: []string{"normal"}, Stats: model.StatSpread{HP: 394, Defense: 236, SpecialDefense: 236}, Boosts: model.StatSpread{Defense: 2}},
+			move:     Move{Type: "fighting", Power: 120, DamageClass: model.DamageClassPhysical},
+			field:    Field{Critical: true},
+			rolls:    []int{1076, 1088, 1104, 1116, 1128, 1140, 1152, 1166, 1178, 1190, 1202, 1218, 1230, 1242, 1254, 1268},
+			percent:  [2]float64{273.0, 321.8},
+			ko:       KOChance{Hits: 1, Chance: 1},
+		},
+		{
+			name:     "critical hit ignores attack drop, keeps defense drop",
+			attacker: Battler{Level: 100, Types: []string{"fighting", "steel"}, Stats: model.StatSpread{Attack: 328, SpecialAttack: 266}, Boosts: model.StatSpread{Attack: -1}},
+			defender: Battler{Types: []string{"normal"}, Stats: model.StatSpread{HP: 394, Defense: 236, SpecialDefense: 236}, Boosts: model.StatSpread{Defense: -1}},
+			move:     Move{Type: "fighting", Power: 120, DamageClass: model.DamageClassPhysical},
+			field:    Field{Critical: true},
+			rolls:    []int{810, 818, 828, 836, 848, 858, 866, 876, 884, 894, 906, 914, 924, 932, 942, 954},
+			percent:  [2]float64{205.5, 242.1},
+			ko:       KOChance{Hits: 1, Chance: 1},
+		},
+		{
+			name:     "burn halves physical damage",
+			attacker: Battler{Level: 100, Types: []string{"fighting"}, Stats: model.StatSpread{Attack: 379, SpecialAttack: 130}, Burned: true, Statused: true},
+			defender: Battler{Types: []string{"normal"}, Stats: model.StatSpread{HP: 394, Defense: 236, SpecialDefense: 236}},
+			move:     Move{Type: "fighting", Power: 75, DamageClass: model.DamageClassPhysical},
+			rolls:    []int{130, 132, 133, 135, 136, 138, 139, 141, 142, 144, 145, 147, 148, 150, 151, 154},
+			percent:  [2]float64{32.9, 39.0},
+			ko:       KOChance{Hits: 3, Chance: 0.9982},
+		},
+		{
+			name:     "Guts ignores burn and boosts attack",
+			attacker: Battler{Level: 100, Types: []string{"fighting"}, Stats: model.StatSpread{Attack: 379, SpecialAttack: 130}, Ability: "guts", Burned: true, Statused: true},
+			defender: Battler{Types: []string{"normal"}, Stats: model.StatSpread{HP: 394, Defense: 236, SpecialDefense: 236}},
+			move:     Move{Type: "fighting", Power: 75, DamageClass: model.DamageClassPhysical},
+			rolls:    []int{390, 392, 398, 402, 408, 410, 416, 420, 426, 428, 434, 438, 444, 446, 452, 458},
+			percent:  [2]float64{98.9, 116.2},
+			ko:       KOChance{Hits: 1, Chance: 0.875},
+		},
+		{
+			name:     "Life Orb",
+			attacker: Battler{Level: 100, Types: []string{"fire", "fighting"}, Stats: model.StatSpread{Attack: 240, SpecialAttack: 304}, Item: "life-orb"},
+			defender: Battler{Types: []string{"grass", "steel"}, Stats: model.StatSpread{HP: 352, Defense: 299, SpecialDefense: 267}},
+			move:     Move{Type: "fire", Power: 90, DamageClass: model.DamageClassSpecial},
+			rolls:    []int{577, 582, 593, 598, 608, 614, 624, 624, 629, 640, 645, 655, 660, 671, 676, 686},
+			percent:  [2]float64{163.9, 194.8},
+			ko:       KOChance{Hits: 1, Chance: 1},
+		},
+		{
+			name:     "Expert Belt chained with Filter",
+			attacker: Battler{Level: 100, Types: []string{"dragon"}, Stats: model.StatSpread{Attack: 250, SpecialAttack: 300}, Item: "expert-belt"},
+			defender: Battler{Types: []string{"dragon", "ground"}, Stats: model.StatSpread{HP: 341, Defense: 226, SpecialDefense: 206}, Ability: "filter"},
+			move:     Move{Type: "ice", Power: 90, DamageClass: model.DamageClassSpecial},
+			rolls:    []int{342, 346, 349, 353, 356, 360, 364, 371, 374, 378, 382, 385, 389, 392, 396, 403},
+			percent:  [2]float64{100.2, 118.1},
+			ko:       KOChance{Hits: 1, Chance: 1},
+		},
+		{
+			name:     "Life Orb chained with Tinted Lens",
+			attacker: Battler{Level: 100, Types: []string{"bug", "flying"}, Stats: model.StatSpread{Attack: 200, SpecialAttack: 306}, Ability: "tinted-lens", Item: "life-orb"},
+			defender: Battler{Types: []string{"fire"}, Stats: model.StatSpread{HP: 301, Defense: 200, SpecialDefense: 206}},
+			move:     Move{Type: "fire", Power: 90, DamageClass: model.DamageClassSpecial},
+			rolls:    []int{125, 127, 127, 130, 130, 133, 133, 135, 138, 138, 140, 140, 143, 143, 146, 148},
+			percent:  [2]float64{41.5, 49.1},
+			ko:       KOChance{Hits: 3, Chance: 1},
+		},
+		{
+			name:     "2HKO chance",
+			attacker: Battler{Level: 100, Types: []string{"water"}, Stats: model.StatSpread{Attack: 200, SpecialAttack: 280}},
+			defender: Battler{Types: []string{"normal"}, Stats: model.StatSpread{HP: 170, Defense: 236, SpecialDefense: 236}},
+			move:     Move{Type: "normal", Power: 90, DamageClass: model.DamageClassSpecial},
+			rolls:    []int{77, 78, 79, 80, 80, 81, 82, 83, 84, 85, 86, 87, 88, 89, 90, 91},
+			percent:  [2]float64{45.2, 53.5},
+			ko:       KOChance{Hits: 2, Chance: 0.371},
+		},
+		{
+			name:     "snow boosts ice types' Defense",
+			attacker: Battler{Level: 100, Types: []string{"dragon", "ground"}, Stats: model.StatSpread{Attack: 394, SpecialAttack: 176}},
+			defender: Battler{Types: []string{"ice", "ground"}, Stats: model.StatSpread{HP: 424, Defense: 196, SpecialDefense: 156}},
+			move:     Move{Type: "ground", Power: 100, DamageClass: model.DamageClassPhysical},
+			field:    Field{Weather: WeatherSnow},
+			rolls:    []int{144, 147, 148, 150, 151, 153, 154, 156, 159, 160, 162, 163, 165, 166, 168, 171},
+			percent:  [2]float64{33.9, 40.3},
+			ko:       KOChance{Hits: 3, Chance: 1},
+		},
+		{
+			name:     "Electric Terrain, OHKO chance",
+			attacker: Battler{Level: 100, Types: []string{"electric"}, Stats: model.StatSpread{Attack: 200, SpecialAttack: 317}},
+			defender: Battler{Types: []string{"water"}, Stats: model.StatSpread{HP: 341, Defense: 236, SpecialDefense: 236}},
+			move:     Move{Type: "electric", Power: 90, DamageClass: model.DamageClassSpecial},
+			field:    Field{Terrain: TerrainElectric},
+			rolls:    []int{338, 344, 348, 350, 356, 360, 362, 368, 372, 374, 380, 384, 386, 392, 396, 402},
+			percent:  [2]float64{99.1, 117.8},
+			ko:       KOChance{Hits: 1, Chance: 0.9375},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Damage(DamageInput{
+				Attacker: tt.attacker,
+				Defender: tt.defender,
+				Move:     tt.move,
+				Field:    tt.field,
+				Chart:    typechart.Latest(),
+			})
+			if err != nil {
+				t.Fatalf("Damage() error = %v", err)
+			}
+
+			if !slices.Equal(result.Rolls, tt.rolls) {
+				t.Errorf("rolls = %v, want %v", result.Rolls, tt.rolls)
+			}
+			if result.Min != tt.rolls[0] || result.Max != tt.rolls[Rolls-1] {
+				t.Errorf("min, max = %d, %d, want %d, %d", result.Min, result.Max, tt.rolls[0], tt.rolls[Rolls-1])
+			}
+			if percent := [2]float64{result.MinPercent, result.MaxPercent}; percent != tt.percent {
+				t.Errorf("percent = %v, want %v", percent, tt.percent)
+			}
+			if result.KO != tt.ko {
+				t.Errorf("KO = %+v, want %+v", result.KO, tt.ko)
+			}
+		})
+	}
+}
+
+func TestDamageImmune(t *testing.T) {
+	result, err := Damage(DamageInput{
+		Attacker: Battler{Level: 100, Types: []string{"dragon", "ground"}, Stats: model.StatSpread{Attack: 394}},
+		Defender: Battler{Types: []string{"steel"}, Ability: "levitate", Stats: model.StatSpread{HP: 300, Defense: 200}},
+		Move:     Move{Type: "ground", Power: 100, DamageClass: model.DamageClassPhysical},
+		Chart:    typechart.Latest(),
+	})
+	if err != nil {
+		t.Fatalf("Damage() error = %v", err)
+	}
+
+	if result.Effectiveness != 0 || result.Max != 0 || result.KO.Hits != 0 {
+		t.Errorf("result = %+v, want no damage", result)
+	}
+}
+
+func TestDamageStatusMove(t *testing.T) {
+	_, err := Damage(DamageInput{
+		Move:  Move{Type: "normal", DamageClass: model.DamageClassStatus},
+		Chart: typechart.Latest(),
+	})
+	if !errors.Is(err, ErrStatusMove) {
+		t.Errorf("Damage() error = %v, want %v", err, ErrStatusMove)
+	}
+}
@@ -0,0 +1,120 @@
+// Package calc implements the battle formulas of the main series games from
+// generation 3 onwards. It only does arithmetic; looking up base stats,
+// natures and moves is up to the caller.
+package calc
+
+import "pokedex_backend_go/pkg/model"
+
+const (
+	MaxLevel   = 100
+	MaxIV      = 31
+	MaxEV      = 252
+	MaxEVTotal = 510
+	// natureBoost and natureDrop are the nature multipliers in percent.
+	natureBoost = 110
+	natureDrop  = 90
+)
+
+// StatInput is what a pokemon's stats depend on. A nil Nature is neutral.
+type StatInput struct {
+	Base   model.StatSpread
+	Level  int
+	IVs    model.StatSpread
+	EVs    model.StatSpread
+	Nature *model.Nature
+}
+
+// Stats computes the six stats with the official formulas:
+//
+//	HP    = floor((2*Base + IV + floor(EV/4)) * Level / 100) + Level + 10
+//	Other = floor((floor((2*Base + IV + floor(EV/4)) * Level / 100) + 5) * Nature)
+//
+// A base HP of 1, which only Shedinja has, always gives 1 HP.
+func Stats(in StatInput) model.StatSpread {
+	var stats model.StatSpread
+	for _, name := range model.StatNames {
+		*stats.Field(name) = Stat(name, in, *in.IVs.Field(name))
+	}
+
+	return stats
+}
+
+// Stat computes a single stat with the given IV, ignoring the IV of the input.
+func Stat(name string, in StatInput, iv int) int {
+	base := *in.Base.Field(name)
+	ev := *in.EVs.Field(name)
+	scaled := (2*base + iv + ev/4) * in.Level / 100
+
+	if name == "hp" {
+		if base == 1 {
+			return 1
+		}
+		return scaled + in.Level + 10
+	}
+
+	return (scaled + 5) * natureMultiplier(in.Nature, name) / 100
+}
+
+func natureMultiplier(nature *model.Nature, stat string) int {
+	switch {
+	case nature == nil:
+		return 100
+	case nature.IncreasedStat != nil && *nature.IncreasedStat == stat:
+		return natureBoost
+	case nature.DecreasedStat != nil && *nature.DecreasedStat == stat:
+		return natureDrop
+	default:
+		return 100
+	}
+}
+
+// InferIVs returns, for every stat, the IVs that produce the observed value
+// with the rest of the input. A stat with no candidates means the observation
+// does not match the input, e.g. a wrong nature or EV spread.
+//
+// A characteristic, when given, keeps only the IVs of its stat with the
+// right remainder and caps the other stats at that stat's highest candidate,
+// since the characteristic names the highest IV.
+func InferIVs(in StatInput, observed model.StatSpread, characteristic *model.Characteristic) map[string][]int {
+	candidates := make(map[string][]int, len(model.StatNames))
+	for _, name := range model.StatNames {
+		want := *observed.Field(name)
+		candidates[name] = []int{}
+		for iv := 0; iv <= MaxIV; iv++ {
+			if Stat(name, in, iv) == want {
+				candidates[name] = append(candidates[name], iv)
+			}
+		}
+	}
+
+	if characteristic == nil {
+		return candidates
+	}
+
+	highest := []int{}
+	for _, iv := range candidates[characteristic.Stat] {
+		if iv%5 == characteristic.IVRemainder {
+			highest = append(highest, iv)
+		}
+	}
+	candidates[characteristic.Stat] = highest
+
+	limit := -1
+	if len(highest) > 0 {
+		limit = highest[len(highest)-1]
+	}
+	for _, name := range model.StatNames {
+		if name == characteristic.Stat {
+			continue
+		}
+		kept := []int{}
+		for _, iv := range candidates[name] {
+			if iv <= limit {
+				kept = append(kept, iv)
+			}
+		}
+		candidates[name] = kept
+	}
+
+	return candidates
+}
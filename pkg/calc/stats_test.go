@@ -0,0 +1,159 @@
+package calc
+
+import (
+	"reflect"
+	"testing"
+
+	"pokedex_backend_go/pkg/model"
+)
+
+var (
+	garchomp = model.StatSpread{HP: 108, Attack: 130, Defense: 95, SpecialAttack: 80, SpecialDefense: 85, Speed: 102}
+	pikachu  = model.StatSpread{HP: 35, Attack: 55, Defense: 40, SpecialAttack: 50, SpecialDefense: 50, Speed: 90}
+	shedinja = model.StatSpread{HP: 1, Attack: 90, Defense: 45, SpecialAttack: 30, SpecialDefense: 30, Speed: 40}
+
+	perfectIVs = model.StatSpread{HP: 31, Attack: 31, Defense: 31, SpecialAttack: 31, SpecialDefense: 31, Speed: 31}
+
+	// jollyGarchomp is the usual level 50 spread: Jolly, 252 Atk / 4 Def /
+	// 252 Spe.
+	jollyGarchomp = StatInput{
+		Base:   garchomp,
+		Level:  50,
+		IVs:    perfectIVs,
+		EVs:    model.StatSpread{Attack: 252, Defense: 4, Speed: 252},
+		Nature: nature("speed", "special_attack"),
+	}
+)
+
+func nature(increased, decreased string) *model.Nature {
+	return &model.Nature{IncreasedStat: &increased, DecreasedStat: &decreased}
+}
+
+func TestStats(t *testing.T) {
+	tests := []struct {
+		name string
+		in   StatInput
+		want model.StatSpread
+	}{
+		{
+			name: "level 50 Jolly Garchomp",
+			in:   jollyGarchomp,
+			want: model.StatSpread{HP: 183, Attack: 182, Defense: 116, SpecialAttack: 90, SpecialDefense: 105, Speed: 169},
+		},
+		{
+			// The worked example of Bulbapedia's stat article.
+			name: "level 78 Adamant Garchomp",
+			in: StatInput{
+				Base:   garchomp,
+				Level:  78,
+				IVs:    model.StatSpread{HP: 24, Attack: 12, Defense: 30, SpecialAttack: 16, SpecialDefense: 23, Speed: 5},
+				EVs:    model.StatSpread{HP: 74, Attack: 190, Defense: 91, SpecialAttack: 48, SpecialDefense: 84, Speed: 23},
+				Nature: nature("attack", "special_attack"),
+			},
+			want: model.StatSpread{HP: 289, Attack: 278, Defense: 193, SpecialAttack: 135, SpecialDefense: 171, Speed: 171},
+		},
+		{
+			name: "Shedinja always has 1 HP",
+			in:   StatInput{Base: shedinja, Level: 100, IVs: perfectIVs, EVs: model.StatSpread{HP: 252}},
+			want: model.StatSpread{HP: 1, Attack: 216, Defense: 126, SpecialAttack: 96, SpecialDefense: 96, Speed: 116},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Stats(tt.in); got != tt.want {
+				t.Errorf("Stats() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInferIVs(t *testing.T) {
+	// Level 20 Pikachu with IVs 22/21/10/12/14/8: every stat matches five
+	// IVs at this level.
+	young := StatInput{Base: pikachu, Level: 20}
+	youngObserved := model.StatSpread{HP: 48, Attack: 31, Defense: 23, SpecialAttack: 27, SpecialDefense: 27, Speed: 42}
+	ivs := func(from, to int) []int {
+		var values []int
+		for iv := from; iv <= to; iv++ {
+			values = append(values, iv)
+		}
+		return values
+	}
+
+	tests := []struct {
+		name           string
+		in             StatInput
+		observed       model.StatSpread
+		characteristic *model.Characteristic
+		want           map[string][]int
+	}{
+		{
+			name:     "level 50 Jolly Garchomp",
+			in:       jollyGarchomp,
+			observed: model.StatSpread{HP: 183, Attack: 182, Defense: 116, SpecialAttack: 90, SpecialDefense: 105, Speed: 169},
+			want: map[string][]int{
+				"hp": {30, 31}, "attack": {31}, "defense": {31},
+				"special_attack": {30, 31}, "special_defense": {30, 31}, "speed": {31},
+			},
+		},
+		{
+			name:     "observed stat no IV explains",
+			in:       jollyGarchomp,
+			observed: model.StatSpread{HP: 183, Attack: 200, Defense: 116, SpecialAttack: 90, SpecialDefense: 105, Speed: 169},
+			want: map[string][]int{
+				"hp": {30, 31}, "attack": {}, "defense": {31},
+				"special_attack": {30, 31}, "special_defense": {30, 31}, "speed": {31},
+			},
+		},
+		{
+			name:     "without a characteristic",
+			in:       young,
+			observed: youngObserved,
+			want: map[string][]int{
+				"hp": ivs(20, 24), "attack": ivs(20, 24), "defense": ivs(10, 14),
+				"special_attack": ivs(10, 14), "special_defense": ivs(10, 14), "speed": ivs(5, 9),
+			},
+		},
+		{
+			// "Nods off a lot": HP is the highest IV and leaves a
+			// remainder of 2, so it is 22 and Attack cannot exceed it.
+			name:           "characteristic keeps its remainder and caps the other stats",
+			in:             young,
+			observed:       youngObserved,
+			characteristic: &model.Characteristic{Stat: "hp", IVRemainder: 2},
+			want: map[string][]int{
+				"hp": {22}, "attack": {20, 21, 22}, "defense": ivs(10, 14),
+				"special_attack": ivs(10, 14), "special_defense": ivs(10, 14), "speed": ivs(5, 9),
+			},
+		},
+		{
+			name:           "characteristic that does not match",
+			in:             jollyGarchomp,
+			observed:       model.StatSpread{HP: 183, Attack: 182, Defense: 116, SpecialAttack: 90, SpecialDefense: 105, Speed: 169},
+			characteristic: &model.Characteristic{Stat: "speed", IVRemainder: 0},
+			want: map[string][]int{
+				"hp": {}, "attack": {}, "defense": {},
+				"special_attack": {}, "special_defense": {}, "speed": {},
+			},
+		},
+		{
+			name:     "Shedinja's HP says nothing about its IV",
+			in:       StatInput{Base: shedinja, Level: 50},
+			observed: model.StatSpread{HP: 1, Attack: 95, Defense: 50, SpecialAttack: 35, SpecialDefense: 35, Speed: 45},
+			want: map[string][]int{
+				"hp": ivs(0, 31), "attack": {0, 1}, "defense": {0, 1},
+				"special_attack": {0, 1}, "special_defense": {0, 1}, "speed": {0, 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := InferIVs(tt.in, tt.observed, tt.characteristic)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("InferIVs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,321 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gookit/config/v2"
+	"github.com/gookit/config/v2/yaml"
+	"go.uber.org/zap"
+)
+
+// FilesEnv lists the configuration files to load, separated by commas. Later
+// files override earlier ones, and environment variables override them all.
+const FilesEnv = "CONFIG_FILES"
+
+const defaultFile = "config.yaml"
+
+type Config struct {
+	App      AppConfig      `mapstructure:"app"`
+	Server   ServerConfig   `mapstructure:"server"`
+	Pprof    PprofConfig    `mapstructure:"pprof"`
+	Database DatabaseConfig `mapstructure:"database"`
+	Log      LogConfig      `mapstructure:"log"`
+	Tracing  TracingConfig  `mapstructure:"tracing"`
+	JWT      JWTConfig      `mapstructure:"jwt"`
+}
+
+type AppConfig struct {
+	Name    string        `mapstructure:"name"`
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+type ServerConfig struct {
+	Host            string        `mapstructure:"host"`
+	Port            int           `mapstructure:"port"`
+	Timeout         time.Duration `mapstructure:"timeout"`
+	ShutdownTimeout time.Duration `mapstructure:"shutdown_timeout"`
+	// DrainDelay is how long /readyz fails before the server stops
+	// accepting connections, so load balancers take it out of rotation
+	// first. It is part of ShutdownTimeout.
+	DrainDelay time.Duration `mapstructure:"drain_delay"`
+}
+
+func (c ServerConfig) Addr() string {
+	return fmt.Sprintf("%s:%d", c.Host, c.Port)
+}
+
+type PprofConfig struct {
+	Enabled bool   `mapstructure:"enabled"`
+	Host    string `mapstructure:"host"`
+	Port    int    `mapstructure:"port"`
+}
+
+func (c PprofConfig) Addr() string {
+	return fmt.Sprintf("%s:%d", c.Host, c.Port)
+}
+
+type DatabaseConfig struct {
+	DSN string `mapstructure:"dsn"`
+	// Migrate applies the pending migrations when the API starts. Turn it
+	// off to run them with cmd/migrate instead, e.g. before a rollout.
+	Migrate bool `mapstructure:"migrate"`
+}
+
+type LogConfig struct {
+	Level string `mapstructure:"level"`
+}
+
+// TracingConfig selects where the spans go: nowhere ("none"), to standard
+// output ("stdout", handy locally) or to an OpenTelemetry collector over
+// OTLP ("otlp-grpc" or "otlp-http").
+type TracingConfig struct {
+	Exporter string `mapstructure:"exporter"`
+	// Endpoint is the host:port of the collector. When empty the exporter
+	// reads OTEL_EXPORTER_OTLP_ENDPOINT or uses its default, localhost:4317
+	// for gRPC and localhost:4318 for HTTP.
+	Endpoint string `mapstructure:"endpoint"`
+	// Insecure sends the spans without TLS, as to a collector next to the
+	// API.
+	Insecure bool `mapstructure:"insecure"`
+	// SampleRatio is the fraction of new traces that are recorded. Requests
+	// that arrive with a sampled parent are always recorded.
+	SampleRatio float64 `mapstructure:"sample_ratio"`
+}
+
+type JWTConfig struct {
+	SigningKeyID     string               `mapstructure:"signing_key_id"`
+	SigningKey       string               `mapstructure:"signing_key"`
+	SigningKeyFile   string               `mapstructure:"signing_key_file"`
+	VerificationKeys []JWTVerificationKey `mapstructure:"verification_keys"`
+	// AllowEphemeralKey signs with a throwaway key when no signing key is
+	// configured. Tokens then break on every restart and between replicas,
+	// so it is only meant for local development.
+	AllowEphemeralKey bool `mapstructure:"allow_ephemeral_key"`
+}
+
+type JWTVerificationKey struct {
+	ID   string `mapstructure:"id"`
+	Key  string `mapstructure:"key"`
+	File string `mapstructure:"file"`
+}
+
+// defaults returns a new map on every call because gookit/config merges later
+// sources into the loaded maps in place.
+func defaults() map[string]any {
+	return map[string]any{
+		"app": map[string]any{
+			"name":    "pokedex_backend_go",
+			"timeout": "1m",
+		},
+		"server": map[string]any{
+			"host":             "0.0.0.0",
+			"port":             3000,
+			"timeout":          "60s",
+			"shutdown_timeout": "10s",
+			"drain_delay":      0,
+		},
+		"database": map[string]any{
+			"migrate": true,
+		},
+		"pprof": map[string]any{
+			"enabled": false,
+			"host":    "localhost",
+			"port":    6060,
+		},
+		"log": map[string]any{
+			"level": "info",
+		},
+		"tracing": map[string]any{
+			"exporter":     "none",
+			"insecure":     false,
+			"sample_ratio": 1,
+		},
+	}
+}
+
+// envOverrides maps environment variables to configuration keys.
+var envOverrides = map[string]string{
+	"APP_NAME":                "app.name",
+	"APP_TIMEOUT":             "app.timeout",
+	"SERVER_HOST":             "server.host",
+	"SERVER_PORT":             "server.port",
+	"SERVER_TIMEOUT":          "server.timeout",
+	"SERVER_SHUTDOWN_TIMEOUT": "server.shutdown_timeout",
+	"SERVER_DRAIN_DELAY":      "server.drain_delay",
+	"PPROF_ENABLED":           "pprof.enabled",
+	"PPROF_HOST":              "pprof.host",
+	"PPROF_PORT":              "pprof.port",
+	"DATABASE_DSN":            "database.dsn",
+	"DATABASE_MIGRATE":        "database.migrate",
+	"LOG_LEVEL":               "log.level",
+	"TRACING_EXPORTER":        "tracing.exporter",
+	"TRACING_ENDPOINT":        "tracing.endpoint",
+	"TRACING_INSECURE":        "tracing.insecure",
+	"TRACING_SAMPLE_RATIO":    "tracing.sample_ratio",
+	"JWT_SIGNING_KEY_ID":      "jwt.signing_key_id",
+	"JWT_SIGNING_KEY":         "jwt.signing_key",
+	"JWT_SIGNING_KEY_FILE":    "jwt.signing_key_file",
+	"JWT_ALLOW_EPHEMERAL_KEY": "jwt.allow_ephemeral_key",
+}
+
+// FilesFromEnv returns the files listed in CONFIG_FILES, or config.yaml when
+// the variable is not set.
+func FilesFromEnv() []string {
+	value := os.Getenv(FilesEnv)
+	if value == "" {
+		return []string{defaultFile}
+	}
+
+	var files []string
+	for _, file := range strings.Split(value, ",") {
+		if file = strings.TrimSpace(file); file != "" {
+			files = append(files, file)
+		}
+	}
+
+	return files
+}
+
+// Load builds the configuration from the defaults, the given YAML or JSON
+// files and the environment, in that order, and validates the result. Files
+// that do not exist are skipped so the defaults plus the environment are
+// enough to run.
+func Load(files ...string) (*Config, error) {
+	c := config.NewEmpty("pokedex_backend_go", config.ParseEnv, config.ParseTime).
+		WithDriver(config.JSONDriver, yaml.Driver)
+
+	if err := c.LoadData(defaults()); err != nil {
+		return nil, fmt.Errorf("failed to load default configuration: %w", err)
+	}
+
+	if err := c.LoadExists(files...); err != nil {
+		return nil, fmt.Errorf("failed to load configuration files %v: %w", files, err)
+	}
+
+	c.LoadOSEnvs(envOverrides)
+
+	cfg := &Config{}
+	if err := c.Decode(cfg); err != nil {
+		return nil, fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	keys, err := verificationKeysFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	if keys != nil {
+		cfg.JWT.VerificationKeys = keys
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	zap.L().Named("config").Info("Configuration loaded", zap.Strings("files", c.LoadedFiles()))
+	return cfg, nil
+}
+
+// verificationKeysFromEnv reads JWT_VERIFICATION_KEYS, a comma separated list
+// of kid=path pairs, since a list of objects cannot be set through a single
+// environment variable.
+func verificationKeysFromEnv() ([]JWTVerificationKey, error) {
+	value := os.Getenv("JWT_VERIFICATION_KEYS")
+	if value == "" {
+		return nil, nil
+	}
+
+	var keys []JWTVerificationKey
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		kid, file, ok := strings.Cut(entry, "=")
+		if !ok || kid == "" || file == "" {
+			return nil, fmt.Errorf("invalid JWT_VERIFICATION_KEYS entry %q, expected kid=path", entry)
+		}
+
+		keys = append(keys, JWTVerificationKey{ID: kid, File: file})
+	}
+
+	return keys, nil
+}
+
+// Validate checks the whole configuration and reports every problem at once
+// instead of stopping at the first one.
+func (c *Config) Validate() error {
+	var problems []string
+	check := func(ok bool, format string, args ...any) {
+		if !ok {
+			problems = append(problems, fmt.Sprintf(format, args...))
+		}
+	}
+
+	check(c.App.Name != "", "app.name is required")
+	check(c.App.Timeout > 0, "app.timeout must be positive")
+
+	check(c.Server.Host != "", "server.host is required")
+	check(validPort(c.Server.Port), "server.port must be between 1 and 65535, got %d", c.Server.Port)
+	check(c.Server.Timeout > 0, "server.timeout must be positive")
+	check(c.Server.ShutdownTimeout > 0, "server.shutdown_timeout must be positive")
+	check(c.Server.DrainDelay >= 0, "server.drain_delay cannot be negative")
+	check(c.Server.DrainDelay < c.Server.ShutdownTimeout, "server.drain_delay must be shorter than server.shutdown_timeout")
+
+	if c.Pprof.Enabled {
+		check(c.Pprof.Host != "", "pprof.host is required when pprof is enabled")
+		check(validPort(c.Pprof.Port), "pprof.port must be between 1 and 65535, got %d", c.Pprof.Port)
+		check(c.Pprof.Port != c.Server.Port || c.Pprof.Host != c.Server.Host, "pprof and server cannot listen on the same address")
+	}
+
+	check(c.Database.DSN != "", "database.dsn is required (DATABASE_DSN)")
+
+	switch strings.ToLower(c.Log.Level) {
+	case "debug", "info", "warn", "error", "dpanic", "panic", "fatal":
+	default:
+		problems = append(problems, fmt.Sprintf("log.level %q is not a valid level", c.Log.Level))
+	}
+
+	switch c.Tracing.Exporter {
+	case "none", "stdout", "otlp-grpc", "otlp-http":
+	default:
+		problems = append(problems, fmt.Sprintf("tracing.exporter %q must be none, stdout, otlp-grpc or otlp-http", c.Tracing.Exporter))
+	}
+	check(c.Tracing.SampleRatio >= 0 && c.Tracing.SampleRatio <= 1, "tracing.sample_ratio must be between 0 and 1, got %g", c.Tracing.SampleRatio)
+
+	hasSigningKey := c.JWT.SigningKey != "" || c.JWT.SigningKeyFile != ""
+	check(!(c.JWT.SigningKey != "" && c.JWT.SigningKeyFile != ""), "jwt.signing_key and jwt.signing_key_file are mutually exclusive")
+	check(!hasSigningKey || c.JWT.SigningKeyID != "", "jwt.signing_key_id is required when a signing key is configured")
+	check(hasSigningKey || c.JWT.AllowEphemeralKey, "jwt.signing_key_file or jwt.signing_key is required unless jwt.allow_ephemeral_key is enabled")
+	check(hasSigningKey || len(c.JWT.VerificationKeys) == 0, "jwt.verification_keys need a jwt.signing_key_file or jwt.signing_key")
+	if c.JWT.SigningKeyFile != "" {
+		check(fileExists(c.JWT.SigningKeyFile), "jwt.signing_key_file %q does not exist", c.JWT.SigningKeyFile)
+	}
+
+	for i, key := range c.JWT.VerificationKeys {
+		check(key.ID != "", "jwt.verification_keys[%d].id is required", i)
+		check(key.Key != "" || key.File != "", "jwt.verification_keys[%d] needs a key or a file", i)
+		if key.File != "" {
+			check(fileExists(key.File), "jwt.verification_keys[%d].file %q does not exist", i, key.File)
+		}
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+
+	return errors.New("invalid configuration:\n  - " + strings.Join(problems, "\n  - "))
+}
+
+func validPort(port int) bool {
+	return port > 0 && port <= 65535
+}
+
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
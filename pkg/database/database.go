@@ -3,22 +3,18 @@ package database
 import (
 	"context"
 	"database/sql"
-	"embed"
-	"fmt"
 	"sync"
 	"time"
 
+	"pokedex_backend_go/pkg/config"
 	"pokedex_backend_go/pkg/logger"
 
 	"github.com/XSAM/otelsql"
-	"github.com/pressly/goose/v3"
 	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 )
 
-var fsys embed.FS
-
 var (
 	lock     sync.Mutex
 	once     sync.Once
@@ -28,21 +24,28 @@ var (
 
 const driverName = "pgx"
 
-func Invoke(_ *sql.DB, _ *gorm.DB) {
-	once.Do(func() {
-		if err := goose.SetDialect("pgx"); err != nil {
-			dbLogger.Error("failed to set goose dialect", zap.Error(err))
-			panic(err)
-		}
-		goose.SetBaseFS(fsys)
-	})
+// Invoke applies the pending migrations at startup unless
+// database.migrate is off, in which case cmd/migrate is expected to run them.
+func Invoke(cfg *config.Config, db *sql.DB, _ *gorm.DB) error {
+	if !cfg.Database.Migrate {
+		dbLogger.Info("skipping database migrations, database.migrate is disabled")
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.App.Timeout)
+	defer cancel()
+
+	if err := MigrationUp(ctx, db); err != nil {
+		dbLogger.Error("failed to run database migrations", zap.Error(err))
+		return err
+	}
+
+	return nil
 }
 
-func Connection() (*sql.DB, error) {
+func Connection(cfg *config.Config) (*sql.DB, error) {
 	dbLogger = logger.NewLogger("database")
 
-	const url = "host=localhost port=5432 user=pokedex_backend_go password=pokedex_backend_go dbname=pokedex_backend_go sslmode=disable search_path=public timezone=UTC"
-
 	opts := []otelsql.Option{
 		otelsql.WithSQLCommenter(true),
 		otelsql.WithAttributes(
@@ -52,7 +55,7 @@ func Connection() (*sql.DB, error) {
 		),
 	}
 
-	db, err := otelsql.Open(driverName, url, opts...)
+	db, err := otelsql.Open(driverName, cfg.Database.DSN, opts...)
 	if err != nil {
 		dbLogger.Error("failed to open database", zap.Error(err))
 		return nil, err
@@ -83,29 +86,3 @@ func Connection() (*sql.DB, error) {
 
 	return db, nil
 }
-
-type gooseLogger struct {
-	*zap.Logger
-}
-
-// Fatalf implements goose.Logger.
-func (g *gooseLogger) Fatalf(format string, v ...interface{}) {
-	g.Logger.Fatal(fmt.Sprintf(format, v...))
-}
-
-// Printf implements goose.Logger.
-func (g *gooseLogger) Printf(format string, v ...interface{}) {
-	g.Logger.Info(fmt.Sprintf(format, v...))
-}
-
-func MigrationUp(ctx context.Context, db *sql.DB) error {
-	dbLogger.Info("running database migrations...")
-	goose.SetLogger(&gooseLogger{dbLogger})
-	return goose.UpContext(ctx, db, "migrations")
-}
-
-func MigrationDown(ctx context.Context, db *sql.DB) error {
-	dbLogger.Info("rolling back database migrations...")
-	goose.SetLogger(&gooseLogger{dbLogger})
-	return goose.DownContext(ctx, db, "migrations")
-}
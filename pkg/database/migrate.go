@@ -0,0 +1,253 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"pokedex_backend_go/migrations"
+
+	"github.com/pressly/goose/v3"
+	"go.uber.org/zap"
+)
+
+// migrationsDir is the directory of the migrations within migrations.FS.
+const migrationsDir = "."
+
+// migrationTemplate is the body of a migration made by CreateMigration.
+const migrationTemplate = `-- +goose Up
+-- +goose StatementBegin
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- +goose StatementEnd
+`
+
+var (
+	migrationFile    = regexp.MustCompile(`^(\d+)_.+\.sql$`)
+	migrationNameSep = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+type gooseLogger struct {
+	*zap.Logger
+}
+
+// Fatalf implements goose.Logger.
+func (g *gooseLogger) Fatalf(format string, v ...interface{}) {
+	g.Logger.Fatal(fmt.Sprintf(format, v...))
+}
+
+// Printf implements goose.Logger.
+func (g *gooseLogger) Printf(format string, v ...interface{}) {
+	g.Logger.Info(strings.TrimSuffix(fmt.Sprintf(format, v...), "\n"))
+}
+
+// setupGoose points goose at the embedded migrations. goose keeps its
+// settings in globals, so this only has to happen once.
+func setupGoose() error {
+	var err error
+	once.Do(func() {
+		err = goose.SetDialect(driverName)
+		goose.SetBaseFS(migrations.FS)
+		goose.SetLogger(&gooseLogger{dbLogger})
+	})
+
+	return err
+}
+
+// baselineVersion is the migration that matches the schema the old
+// AutoMigrate created: the users table and nothing else.
+const baselineVersion = 1
+
+// baselineLegacySchema marks migration 1 as applied on the databases that
+// AutoMigrate created before the migrations existed. They already have the
+// users table but no version table, so 001 would fail on them. It also
+// brings their users table in line with 001: the later migrations reuse its
+// update_updated_at_column(), and AutoMigrate had replaced the username
+// constraint with a deferrable one.
+func baselineLegacySchema(ctx context.Context, db *sql.DB) error {
+	var users, versions sql.NullString
+	err := db.QueryRowContext(ctx, "SELECT to_regclass('users')::text, to_regclass($1::text)::text", goose.TableName()).
+		Scan(&users, &versions)
+	if err != nil {
+		return err
+	}
+	if !users.Valid || versions.Valid {
+		return nil
+	}
+
+	dbLogger.Info("database predates the migrations, marking the baseline as applied", zap.Int64("version", baselineVersion))
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// The version table is the same goose creates, built here so that it,
+	// the baseline row and the fixes to users land in one transaction.
+	statements := []string{
+		fmt.Sprintf(`CREATE TABLE %s (
+			id integer PRIMARY KEY GENERATED BY DEFAULT AS IDENTITY,
+			version_id bigint NOT NULL,
+			is_applied boolean NOT NULL,
+			tstamp timestamp NOT NULL DEFAULT now()
+		)`, goose.TableName()),
+		fmt.Sprintf("INSERT INTO %s (version_id, is_applied) VALUES (0, true), (%d, true)", goose.TableName(), baselineVersion),
+		`CREATE EXTENSION IF NOT EXISTS "uuid-ossp"`,
+		`CREATE OR REPLACE FUNCTION update_updated_at_column()
+		RETURNS TRIGGER AS $$
+		BEGIN
+			NEW.updated_at = NOW();
+			RETURN NEW;
+		END;
+		$$ language 'plpgsql'`,
+		`DROP TRIGGER IF EXISTS update_users_updated_at ON users`,
+		`CREATE TRIGGER update_users_updated_at BEFORE UPDATE ON users
+			FOR EACH ROW EXECUTE FUNCTION update_updated_at_column()`,
+		`ALTER TABLE users DROP CONSTRAINT IF EXISTS users_username_unique`,
+		`DO $$
+		BEGIN
+			IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'users_username_key') THEN
+				ALTER TABLE users ADD CONSTRAINT users_username_key UNIQUE (username);
+			END IF;
+		END $$`,
+	}
+	for _, statement := range statements {
+		if _, err := tx.ExecContext(ctx, statement); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// MigrationUp applies every pending migration, after marking the baseline
+// as applied on databases that predate the migrations.
+func MigrationUp(ctx context.Context, db *sql.DB) error {
+	if err := setupGoose(); err != nil {
+		return err
+	}
+	if err := baselineLegacySchema(ctx, db); err != nil {
+		return err
+	}
+
+	dbLogger.Info("running database migrations...")
+	return goose.UpContext(ctx, db, migrationsDir)
+}
+
+// MigrationUpTo applies the pending migrations up to version, included.
+func MigrationUpTo(ctx context.Context, db *sql.DB, version int64) error {
+	if err := setupGoose(); err != nil {
+		return err
+	}
+	if err := baselineLegacySchema(ctx, db); err != nil {
+		return err
+	}
+
+	dbLogger.Info("running database migrations...", zap.Int64("version", version))
+	return goose.UpToContext(ctx, db, migrationsDir, version)
+}
+
+// MigrationDown rolls back the last applied migration.
+func MigrationDown(ctx context.Context, db *sql.DB) error {
+	if err := setupGoose(); err != nil {
+		return err
+	}
+
+	dbLogger.Info("rolling back database migrations...")
+	return goose.DownContext(ctx, db, migrationsDir)
+}
+
+// MigrationRedo rolls back the last applied migration and applies it again.
+func MigrationRedo(ctx context.Context, db *sql.DB) error {
+	if err := setupGoose(); err != nil {
+		return err
+	}
+
+	dbLogger.Info("redoing the last database migration...")
+	return goose.RedoContext(ctx, db, migrationsDir)
+}
+
+// MigrationStatus logs whether each migration is applied or pending.
+func MigrationStatus(ctx context.Context, db *sql.DB) error {
+	if err := setupGoose(); err != nil {
+		return err
+	}
+
+	return goose.StatusContext(ctx, db, migrationsDir)
+}
+
+// MigrationVersions returns the version the database is at and the latest
+// embedded migration. Unlike the goose commands it only reads, so it never
+// creates the version table, which makes it safe for readiness probes.
+func MigrationVersions(ctx context.Context, db *sql.DB) (current, latest int64, err error) {
+	if err := setupGoose(); err != nil {
+		return 0, 0, err
+	}
+
+	collected, err := goose.CollectMigrations(migrationsDir, 0, goose.MaxVersion)
+	if err != nil {
+		return 0, 0, err
+	}
+	last, err := collected.Last()
+	if err != nil {
+		return 0, 0, err
+	}
+	latest = last.Version
+
+	// goose deletes the row of a migration when it is rolled back, so the
+	// highest applied version is the current one.
+	query := fmt.Sprintf("SELECT COALESCE(MAX(version_id), 0) FROM %s WHERE is_applied", goose.TableName())
+	if err := db.QueryRowContext(ctx, query).Scan(&current); err != nil {
+		return 0, latest, err
+	}
+
+	return current, latest, nil
+}
+
+// CreateMigration writes an empty SQL migration to dir, numbered after the
+// last one there like the existing files (017_add_something.sql), and
+// returns its path.
+func CreateMigration(dir, name string) (string, error) {
+	name = strings.Trim(migrationNameSep.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if name == "" {
+		return "", errors.New("the migration name needs letters or digits")
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", err
+	}
+
+	last := 0
+	for _, entry := range entries {
+		match := migrationFile.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+		if version, err := strconv.Atoi(match[1]); err == nil && version > last {
+			last = version
+		}
+	}
+
+	path := filepath.Join(dir, fmt.Sprintf("%03d_%s.sql", last+1, name))
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	if _, err := f.WriteString(migrationTemplate); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
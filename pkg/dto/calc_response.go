@@ -0,0 +1,56 @@
+package dto
+
+import "pokedex_backend_go/pkg/model"
+
+// IVRange lists the IVs consistent with an observed stat. Min and Max are
+// null when no IV matches.
+type IVRange struct {
+	Min    *int  `json:"min"`
+	Max    *int  `json:"max"`
+	Values []int `json:"values"`
+}
+
+// StatsResponse holds either the computed stats or, when observed stats were
+// sent, the IVs that explain them.
+type StatsResponse struct {
+	Pokemon    string             `json:"pokemon"`
+	Level      int                `json:"level"`
+	Nature     string             `json:"nature"`
+	Base       model.StatSpread   `json:"base"`
+	EVs        model.StatSpread   `json:"evs"`
+	IVs        *model.StatSpread  `json:"ivs,omitempty"`
+	Stats      *model.StatSpread  `json:"stats,omitempty"`
+	Observed   *model.StatSpread  `json:"observed,omitempty"`
+	IVRanges   map[string]IVRange `json:"iv_ranges,omitempty"`
+	Consistent *bool              `json:"consistent,omitempty"`
+}
+
+// KOChance is the probability of knocking the defender out in Hits hits.
+// Both are zero when the move can't KO within eight hits.
+type KOChance struct {
+	Hits   int     `json:"hits"`
+	Chance float64 `json:"chance"`
+}
+
+// DamageResponse lists the sixteen damage rolls of a move, from the 85% to
+// the 100% roll. Percentages are of the defender's full HP.
+type DamageResponse struct {
+	Attacker      string   `json:"attacker"`
+	Defender      string   `json:"defender"`
+	Move          string   `json:"move"`
+	Type          string   `json:"type"`
+	DamageClass   string   `json:"damage_class"`
+	Power         int      `json:"power"`
+	Weather       string   `json:"weather"`
+	Terrain       string   `json:"terrain"`
+	Critical      bool     `json:"critical"`
+	Spread        bool     `json:"spread"`
+	Effectiveness float64  `json:"effectiveness"`
+	Rolls         []int    `json:"rolls"`
+	Min           int      `json:"min"`
+	Max           int      `json:"max"`
+	MinPercent    float64  `json:"min_percent"`
+	MaxPercent    float64  `json:"max_percent"`
+	DefenderHP    int      `json:"defender_hp"`
+	KO            KOChance `json:"ko"`
+}
@@ -0,0 +1,72 @@
+package dto
+
+import "pokedex_backend_go/pkg/model"
+
+type MoveResponse struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	DisplayName  string `json:"display_name"`
+	Generation   int    `json:"generation"`
+	Type         string `json:"type"`
+	DamageClass  string `json:"damage_class"`
+	Power        *int   `json:"power"`
+	Accuracy     *int   `json:"accuracy"`
+	PP           *int   `json:"pp"`
+	Priority     int    `json:"priority"`
+	EffectChance *int   `json:"effect_chance"`
+	ShortEffect  string `json:"short_effect"`
+	Effect       string `json:"effect"`
+	FlavorText   string `json:"flavor_text"`
+}
+
+type MoveListResponse struct {
+	Items    []MoveResponse `json:"items"`
+	Page     int            `json:"page"`
+	PageSize int            `json:"page_size"`
+	Total    int64          `json:"total"`
+}
+
+type AbilityResponse struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	DisplayName  string `json:"display_name"`
+	Generation   int    `json:"generation"`
+	IsMainSeries bool   `json:"is_main_series"`
+	ShortEffect  string `json:"short_effect"`
+	Effect       string `json:"effect"`
+	FlavorText   string `json:"flavor_text"`
+}
+
+type AbilityListResponse struct {
+	Items    []AbilityResponse `json:"items"`
+	Page     int               `json:"page"`
+	PageSize int               `json:"page_size"`
+	Total    int64             `json:"total"`
+}
+
+type ItemListResponse struct {
+	Items    []model.Item `json:"items"`
+	Page     int          `json:"page"`
+	PageSize int          `json:"page_size"`
+	Total    int64        `json:"total"`
+}
+
+// LearnedMove is a move of a learnset. Level is 0 for every method but
+// level-up.
+type LearnedMove struct {
+	Move        string `json:"move"`
+	DisplayName string `json:"display_name"`
+	Type        string `json:"type"`
+	DamageClass string `json:"damage_class"`
+	Power       *int   `json:"power"`
+	Accuracy    *int   `json:"accuracy"`
+	PP          *int   `json:"pp"`
+	Method      string `json:"method"`
+	Level       int    `json:"level"`
+}
+
+type PokemonMovesResponse struct {
+	Pokemon      string        `json:"pokemon"`
+	VersionGroup string        `json:"version_group"`
+	Moves        []LearnedMove `json:"moves"`
+}
@@ -0,0 +1,17 @@
+package dto
+
+import "pokedex_backend_go/pkg/validation"
+
+// ProblemResponse is the body of every error, an RFC 7807 problem. Code
+// names the problem like the last segment of Type and is meant for clients
+// to switch on; Title and Detail are translated to the language of the
+// request.
+type ProblemResponse struct {
+	Type     string            `json:"type"`
+	Title    string            `json:"title"`
+	Status   int               `json:"status"`
+	Detail   string            `json:"detail,omitempty"`
+	Instance string            `json:"instance,omitempty"`
+	Code     string            `json:"code"`
+	Errors   validation.Errors `json:"errors,omitempty"`
+}
@@ -0,0 +1,15 @@
+package dto
+
+// HealthResponse is the body of /healthz and /readyz. Status is "ok",
+// "unavailable" when a check failed or "draining" once the server is
+// shutting down.
+type HealthResponse struct {
+	Status string                 `json:"status"`
+	Checks map[string]HealthCheck `json:"checks,omitempty"`
+}
+
+type HealthCheck struct {
+	Status    string  `json:"status"`
+	LatencyMs float64 `json:"latency_ms"`
+	Error     string  `json:"error,omitempty"`
+}
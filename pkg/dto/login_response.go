@@ -3,6 +3,6 @@ package dto
 import "pokedex_backend_go/pkg/model"
 
 type LoginResponse struct {
-	User  model.User `json:"user"`
-	Token string     `json:"token"`
+	User model.User `json:"user"`
+	TokenResponse
 }
@@ -0,0 +1,51 @@
+package dto
+
+type TypeChartResponse struct {
+	Generation int                           `json:"generation"`
+	Types      []string                      `json:"types"`
+	Chart      map[string]map[string]float64 `json:"chart"`
+}
+
+type DefenseResponse struct {
+	Generation  int                `json:"generation"`
+	Types       []string           `json:"types"`
+	Multipliers map[string]float64 `json:"multipliers"`
+	Weaknesses  []string           `json:"weaknesses"`
+	Resistances []string           `json:"resistances"`
+	Immunities  []string           `json:"immunities"`
+}
+
+// CoverageResponse gives, for every defending type, the best multiplier the
+// attacking types reach against it.
+type CoverageResponse struct {
+	Generation     int                `json:"generation"`
+	AttackingTypes []string           `json:"attacking_types"`
+	IgnoredMoves   []string           `json:"ignored_moves"`
+	Best           map[string]float64 `json:"best"`
+	SuperEffective []string           `json:"super_effective"`
+	Neutral        []string           `json:"neutral"`
+	Resisted       []string           `json:"resisted"`
+	NoEffect       []string           `json:"no_effect"`
+}
+
+type MemberMatchup struct {
+	Pokemon     string             `json:"pokemon"`
+	Types       []string           `json:"types"`
+	Multipliers map[string]float64 `json:"multipliers"`
+}
+
+// TypeMatchupSummary counts how many members are weak to, resist or are
+// immune to an attacking type.
+type TypeMatchupSummary struct {
+	Type    string `json:"type"`
+	Weak    int    `json:"weak"`
+	Neutral int    `json:"neutral"`
+	Resist  int    `json:"resist"`
+	Immune  int    `json:"immune"`
+}
+
+type TeamMatchupResponse struct {
+	Generation int                  `json:"generation"`
+	Members    []MemberMatchup      `json:"members"`
+	Summary    []TypeMatchupSummary `json:"summary"`
+}
@@ -0,0 +1,58 @@
+package dto
+
+import "time"
+
+type PokedexEntryResponse struct {
+	SpeciesID   int       `json:"species_id"`
+	SpeciesName string    `json:"species_name"`
+	FormID      *int      `json:"form_id"`
+	FormName    *string   `json:"form_name"`
+	Status      string    `json:"status"`
+	Version     *string   `json:"version"`
+	MarkedAt    time.Time `json:"marked_at"`
+}
+
+type PokedexListResponse struct {
+	Entries []PokedexEntryResponse `json:"entries"`
+	Total   int                    `json:"total"`
+}
+
+type PokedexBulkResponse struct {
+	Marked   int   `json:"marked"`
+	Unmarked int64 `json:"unmarked"`
+}
+
+// ProgressCounts counts the species of a group. Percent is the share of caught
+// species and ShinyPercent the share of shiny ones.
+type ProgressCounts struct {
+	Total        int     `json:"total"`
+	Seen         int     `json:"seen"`
+	Caught       int     `json:"caught"`
+	Shiny        int     `json:"shiny"`
+	Percent      float64 `json:"percent"`
+	ShinyPercent float64 `json:"shiny_percent"`
+}
+
+type GenerationProgress struct {
+	Generation int `json:"generation"`
+	ProgressCounts
+}
+
+type PokedexProgress struct {
+	Pokedex string `json:"pokedex"`
+	ProgressCounts
+}
+
+type TypeProgress struct {
+	Type string `json:"type"`
+	ProgressCounts
+}
+
+// PokedexProgressResponse reports completion overall and per group. The
+// overall caught count is the size of the user's living dex.
+type PokedexProgressResponse struct {
+	Overall     ProgressCounts       `json:"overall"`
+	Generations []GenerationProgress `json:"generations"`
+	Pokedexes   []PokedexProgress    `json:"pokedexes"`
+	Types       []TypeProgress       `json:"types"`
+}
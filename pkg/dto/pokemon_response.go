@@ -0,0 +1,87 @@
+package dto
+
+import "pokedex_backend_go/pkg/model"
+
+type PokemonSummary struct {
+	ID          int      `json:"id"`
+	Name        string   `json:"name"`
+	DisplayName string   `json:"display_name"`
+	Generation  int      `json:"generation"`
+	Types       []string `json:"types"`
+}
+
+type PokemonListResponse struct {
+	Items    []PokemonSummary `json:"items"`
+	Page     int              `json:"page"`
+	PageSize int              `json:"page_size"`
+	Total    int64            `json:"total"`
+}
+
+// PokemonResponse is a species with its name, genus ("Mach Pokémon") and
+// latest flavor text in the negotiated language.
+type PokemonResponse struct {
+	Pokemon     model.PokemonSpecies `json:"pokemon"`
+	DisplayName string               `json:"display_name"`
+	Genus       string               `json:"genus"`
+	FlavorText  string               `json:"flavor_text"`
+}
+
+// EvolutionCondition is one way of evolving from the parent node. Only the
+// conditions that apply are set, and all of them must hold at once.
+type EvolutionCondition struct {
+	Trigger               string  `json:"trigger"`
+	MinimumLevel          *int    `json:"minimum_level,omitempty"`
+	Item                  *string `json:"item,omitempty"`
+	HeldItem              *string `json:"held_item,omitempty"`
+	KnownMove             *string `json:"known_move,omitempty"`
+	KnownMoveType         *string `json:"known_move_type,omitempty"`
+	Location              *string `json:"location,omitempty"`
+	TimeOfDay             *string `json:"time_of_day,omitempty"`
+	Gender                *string `json:"gender,omitempty"`
+	MinimumHappiness      *int    `json:"minimum_happiness,omitempty"`
+	MinimumBeauty         *int    `json:"minimum_beauty,omitempty"`
+	MinimumAffection      *int    `json:"minimum_affection,omitempty"`
+	RelativePhysicalStats *int    `json:"relative_physical_stats,omitempty"`
+	PartySpecies          *string `json:"party_species,omitempty"`
+	PartyType             *string `json:"party_type,omitempty"`
+	TradeSpecies          *string `json:"trade_species,omitempty"`
+	NeedsOverworldRain    bool    `json:"needs_overworld_rain,omitempty"`
+	TurnUpsideDown        bool    `json:"turn_upside_down,omitempty"`
+}
+
+// EvolutionNode is a species of the chain. Conditions is empty for the root.
+type EvolutionNode struct {
+	ID          int                  `json:"id"`
+	Name        string               `json:"name"`
+	DisplayName string               `json:"display_name"`
+	IsBaby      bool                 `json:"is_baby"`
+	Conditions  []EvolutionCondition `json:"conditions"`
+	EvolvesTo   []EvolutionNode      `json:"evolves_to"`
+}
+
+// EvolutionChainResponse is the whole chain of a species, starting from its
+// first stage. ID is null for species that are not part of any chain.
+type EvolutionChainResponse struct {
+	ID              *int          `json:"id"`
+	BabyTriggerItem *string       `json:"baby_trigger_item"`
+	Chain           EvolutionNode `json:"chain"`
+}
+
+type PokemonSearchResult struct {
+	ID          int              `json:"id"`
+	Name        string           `json:"name"`
+	DisplayName string           `json:"display_name"`
+	SpeciesID   int              `json:"species_id"`
+	IsDefault   bool             `json:"is_default"`
+	Types       []string         `json:"types"`
+	Abilities   []string         `json:"abilities"`
+	Stats       model.StatSpread `json:"stats"`
+	Total       int              `json:"bst"`
+}
+
+type PokemonSearchResponse struct {
+	Items    []PokemonSearchResult `json:"items"`
+	Page     int                   `json:"page"`
+	PageSize int                   `json:"page_size"`
+	Total    int64                 `json:"total"`
+}
@@ -0,0 +1,34 @@
+package dto
+
+import (
+	"time"
+
+	"pokedex_backend_go/pkg/model"
+)
+
+type TeamMemberResponse struct {
+	Slot     int              `json:"slot"`
+	Pokemon  string           `json:"pokemon"`
+	Nickname string           `json:"nickname"`
+	Level    int              `json:"level"`
+	Ability  string           `json:"ability"`
+	Item     string           `json:"item"`
+	Nature   string           `json:"nature"`
+	Shiny    bool             `json:"shiny"`
+	Moves    []string         `json:"moves"`
+	EVs      model.StatSpread `json:"evs"`
+	IVs      model.StatSpread `json:"ivs"`
+}
+
+type TeamResponse struct {
+	ID        string               `json:"id"`
+	Name      string               `json:"name"`
+	Format    string               `json:"format"`
+	Members   []TeamMemberResponse `json:"members"`
+	CreatedAt time.Time            `json:"created_at"`
+	UpdatedAt time.Time            `json:"updated_at"`
+}
+
+type TeamListResponse struct {
+	Teams []TeamResponse `json:"teams"`
+}
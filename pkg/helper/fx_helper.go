@@ -3,19 +3,21 @@ package fxhelper
 import (
 	"time"
 
+	"pokedex_backend_go/pkg/config"
+
 	"go.uber.org/fx/fxevent"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
 
-func Logger() fxevent.Logger {
-	appName := "pokedex_backend_go"
+func Logger(cfg *config.Config) fxevent.Logger {
+	appName := cfg.App.Name
 	logger := zap.L().WithOptions(zap.IncreaseLevel(zapcore.WarnLevel), zap.WithCaller(false)).Named(appName)
 	return &fxevent.ZapLogger{
 		Logger: logger,
 	}
 }
 
-func Timeout() time.Duration {
-	return time.Minute
+func Timeout(cfg *config.Config) time.Duration {
+	return cfg.App.Timeout
 }
@@ -0,0 +1,44 @@
+package i18n
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/message/catalog"
+)
+
+// messages is the catalog of API messages. Keys are the English formats,
+// which are also what Default renders, so a missing translation falls back
+// to English.
+var messages = catalog.NewBuilder(catalog.Fallback(Default))
+
+func init() {
+	for tag, translations := range map[language.Tag]map[string]string{
+		language.Spanish:            spanish,
+		language.French:             french,
+		language.German:             german,
+		language.Japanese:           japanese,
+		language.Korean:             korean,
+		language.SimplifiedChinese:  simplifiedChinese,
+		language.TraditionalChinese: traditionalChinese,
+	} {
+		for key, translation := range translations {
+			if err := messages.SetString(tag, key, translation); err != nil {
+				panic(fmt.Sprintf("i18n: invalid %s translation of %q: %v", tag, key, err))
+			}
+		}
+	}
+}
+
+// Printer returns a printer for the language of the context.
+func Printer(ctx context.Context) *message.Printer {
+	return message.NewPrinter(FromContext(ctx), message.Catalog(messages))
+}
+
+// Sprintf translates the English format key to the language of the context
+// and formats it with args.
+func Sprintf(ctx context.Context, key string, args ...any) string {
+	return Printer(ctx).Sprintf(key, args...)
+}
@@ -0,0 +1,105 @@
+// Package i18n negotiates the language of a request among the ones the
+// catalog has translations for.
+package i18n
+
+import (
+	"context"
+	"net/http"
+
+	"golang.org/x/text/language"
+)
+
+// Default is the language served when nothing better matches, and the one
+// every translation falls back to.
+var Default = language.English
+
+// Supported lists the languages with translations, Default first as the
+// matcher falls back to the first one.
+var Supported = []language.Tag{
+	Default,
+	language.Spanish,
+	language.French,
+	language.German,
+	language.Japanese,
+	language.Korean,
+	language.SimplifiedChinese,
+	language.TraditionalChinese,
+}
+
+var matcher = language.NewMatcher(Supported)
+
+type contextKey struct{}
+
+// Negotiate picks the supported language closest to the ?lang= parameter,
+// then to the Accept-Language header. es-MX matches es and zh-TW matches
+// zh-Hant; anything unsupported falls back to Default.
+func Negotiate(r *http.Request) language.Tag {
+	var desired []language.Tag
+	if lang := r.URL.Query().Get("lang"); lang != "" {
+		if tag, err := language.Parse(lang); err == nil {
+			desired = append(desired, tag)
+		}
+	}
+
+	accepted, _, _ := language.ParseAcceptLanguage(r.Header.Get("Accept-Language"))
+	desired = append(desired, accepted...)
+
+	_, index, _ := matcher.Match(desired...)
+	return Supported[index]
+}
+
+// Middleware negotiates the language of every request and stores it in the
+// request context.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tag := Negotiate(r)
+
+		w.Header().Set("Content-Language", tag.String())
+		w.Header().Add("Vary", "Accept-Language")
+
+		next.ServeHTTP(w, r.WithContext(WithLanguage(r.Context(), tag)))
+	})
+}
+
+func WithLanguage(ctx context.Context, tag language.Tag) context.Context {
+	return context.WithValue(ctx, contextKey{}, tag)
+}
+
+// FromContext returns the negotiated language, or Default outside of a
+// request.
+func FromContext(ctx context.Context) language.Tag {
+	if tag, ok := ctx.Value(contextKey{}).(language.Tag); ok {
+		return tag
+	}
+
+	return Default
+}
+
+// Code returns the code translations are stored under, e.g. "es" or
+// "zh-Hant".
+func Code(tag language.Tag) string {
+	return tag.String()
+}
+
+// Languages returns the codes to load translations for: the language of
+// the context and Default.
+func Languages(ctx context.Context) []string {
+	code := Code(FromContext(ctx))
+	if code == Code(Default) {
+		return []string{code}
+	}
+
+	return []string{code, Code(Default)}
+}
+
+// Pick returns the first non-empty value, for falling back from the
+// requested language to Default and then to the identifier.
+func Pick(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
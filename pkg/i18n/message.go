@@ -0,0 +1,24 @@
+package i18n
+
+import "fmt"
+
+// Message is an error whose text can be translated: it reads as Key
+// formatted with Args, and wraps Err so callers can still match on it.
+type Message struct {
+	Err  error
+	Key  string
+	Args []any
+}
+
+// Errorf returns a Message wrapping err.
+func Errorf(err error, key string, args ...any) error {
+	return &Message{Err: err, Key: key, Args: args}
+}
+
+func (m *Message) Error() string {
+	return fmt.Sprintf(m.Key, m.Args...)
+}
+
+func (m *Message) Unwrap() error {
+	return m.Err
+}
@@ -0,0 +1,141 @@
+package i18n
+
+// german translates the API messages, keyed by their English format.
+var german = map[string]string{
+	// Generic
+	"Internal server error":      "Interner Serverfehler",
+	"Invalid JSON format":        "Ungültiges JSON-Format",
+	"Invalid request":            "Ungültige Anfrage",
+	"page must be a number":      "page muss eine Zahl sein",
+	"page_size must be a number": "page_size muss eine Zahl sein",
+	"page must be at least 1 and page_size between 1 and 100": "page muss mindestens 1 und page_size zwischen 1 und 100 sein",
+	"generation must be a number":                             "generation muss eine Zahl sein",
+
+	// Requests
+	"Request body is too large":                     "Der Anfragetext ist zu groß",
+	"request body must be at most %d bytes":         "der Anfragetext darf höchstens %d Bytes groß sein",
+	"request body is empty":                         "der Anfragetext ist leer",
+	"request body ends unexpectedly":                "der Anfragetext endet unerwartet",
+	"malformed JSON at byte %d":                     "fehlerhaftes JSON bei Byte %d",
+	"request body must be a JSON object":            "der Anfragetext muss ein JSON-Objekt sein",
+	"request body must contain a single JSON value": "der Anfragetext darf nur einen JSON-Wert enthalten",
+	"unknown field":                                 "unbekanntes Feld",
+	"%s must be of type %s":                         "%s muss vom Typ %s sein",
+	"%s is required":                                "%s ist erforderlich",
+	"%s must be a valid email address":              "%s muss eine gültige E-Mail-Adresse sein",
+	"%s must be one of %s":                          "%s muss einer der Werte %s sein",
+	"%s must be at least %d characters long":        "%s muss mindestens %d Zeichen lang sein",
+	"%s must be at most %d characters long":         "%s darf höchstens %d Zeichen lang sein",
+	"%s must be at least %d":                        "%s muss mindestens %d sein",
+	"%s must be at most %d":                         "%s darf höchstens %d sein",
+	"%s must have at least %d items":                "%s muss mindestens %d Elemente haben",
+	"%s must have at most %d items":                 "%s darf höchstens %d Elemente haben",
+
+	// Authentication
+	"Authorization header required":       "Authorization-Header erforderlich",
+	"Invalid Authorization header format": "Ungültiges Format des Authorization-Headers",
+	"Invalid token":                       "Ungültiges Token",
+	"Invalid refresh token":               "Ungültiges Refresh-Token",
+	"Invalid email or password":           "Ungültige E-Mail-Adresse oder ungültiges Passwort",
+	"Email already exists":                "E-Mail-Adresse existiert bereits",
+
+	// Profile
+	"User not found":                     "Benutzer nicht gefunden",
+	"Username already exists":            "Benutzername existiert bereits",
+	"user ID is required":                "Benutzer-ID ist erforderlich",
+	"no updates provided":                "keine Änderungen angegeben",
+	"no valid updates provided":          "keine gültigen Änderungen angegeben",
+	"field is not allowed for update":    "das Feld darf nicht geändert werden",
+	"field %q is not allowed for update": "das Feld %q darf nicht geändert werden",
+
+	// Pokédex
+	"Pokemon not found":         "Pokémon nicht gefunden",
+	"Move not found":            "Attacke nicht gefunden",
+	"Ability not found":         "Fähigkeit nicht gefunden",
+	"Item not found":            "Item nicht gefunden",
+	"Base stats are not loaded": "Die Basiswerte sind nicht geladen",
+	"Unknown version":           "Unbekannte Version",
+	"damage_class must be physical, special or status": "damage_class muss physical, special oder status sein",
+	"holdable must be true or false":                   "holdable muss true oder false sein",
+
+	// Search
+	"%s: expected field, operator and value, e.g. type:fire or speed>=100": "%s: Feld, Operator und Wert erwartet, z. B. type:fire oder speed>=100",
+	"%s: unknown field %q":                                     "%s: unbekanntes Feld %q",
+	"%s: missing value":                                        "%s: Wert fehlt",
+	"%s: %s only supports : and !=":                            "%s: %s unterstützt nur : und !=",
+	"%s: %s must be compared to a number":                      "%s: %s muss mit einer Zahl verglichen werden",
+	"%s: %s must be true or false":                             "%s: %s muss true oder false sein",
+	"%s: at most %d terms are allowed":                         "%s: höchstens %d Begriffe sind erlaubt",
+	"%s: unterminated quote":                                   "%s: nicht geschlossenes Anführungszeichen",
+	"%s: can only sort by id, name, generation, a stat or bst": "%s: Sortierung nur nach id, name, generation, einem Statuswert oder bst möglich",
+	"%s: unknown type %q":                                      "%s: unbekannter Typ %q",
+
+	// Collection
+	"species_id must be a number":            "species_id muss eine Zahl sein",
+	"form_id must be a number":               "form_id muss eine Zahl sein",
+	"species not found":                      "Spezies nicht gefunden",
+	"form not found for this species":        "Form für diese Spezies nicht gefunden",
+	"unknown game version":                   "unbekannte Spielversion",
+	"status must be seen, caught or shiny":   "status muss seen, caught oder shiny sein",
+	"marked_at cannot be in the future":      "marked_at darf nicht in der Zukunft liegen",
+	"at most %d entries can be sent at once": "höchstens %d Einträge können auf einmal gesendet werden",
+	"too many entries":                       "zu viele Einträge",
+
+	// Teams
+	"Team not found":                         "Team nicht gefunden",
+	"name is required":                       "Name ist erforderlich",
+	"name must be at most %d characters":     "Name darf höchstens %d Zeichen lang sein",
+	"format must be at most %d characters":   "Format darf höchstens %d Zeichen lang sein",
+	"nickname must be at most %d characters": "Spitzname darf höchstens %d Zeichen lang sein",
+	"a team has at most %d members":          "ein Team hat höchstens %d Mitglieder",
+	"a pokemon knows at most %d moves":       "ein Pokémon beherrscht höchstens %d Attacken",
+	"species already used by members[%d]":    "Spezies wird bereits von members[%d] verwendet",
+	"ability is required":                    "Fähigkeit ist erforderlich",
+	"%s cannot have the ability %q":          "%s kann die Fähigkeit %q nicht haben",
+	"move %q is already known":               "Attacke %q ist bereits bekannt",
+	"must be between 0 and %d, got %d":       "muss zwischen 0 und %d liegen, erhalten: %d",
+	"the paste contains no pokemon":          "der Text enthält kein Pokémon",
+	"missing species":                        "Spezies fehlt",
+	"missing move name":                      "Name der Attacke fehlt",
+	"level must be a number":                 "Level muss eine Zahl sein",
+	"invalid stat %q":                        "ungültiger Statuswert %q",
+	"invalid stat value %q":                  "ungültige Zahl für Statuswert %q",
+	"unknown stat %q":                        "unbekannter Statuswert %q",
+
+	// Calculators
+	"level must be between 1 and %d":                                       "Level muss zwischen 1 und %d liegen",
+	"EVs add up to %d, the maximum is %d":                                  "die EVs ergeben %d, das Maximum ist %d",
+	"must be between %d and %d, got %d":                                    "muss zwischen %d und %d liegen, erhalten: %d",
+	"must be at least %d, got %d":                                          "muss mindestens %d sein, erhalten: %d",
+	"unknown characteristic %q":                                            "unbekanntes Charakteristikum %q",
+	"a characteristic only applies when inferring IVs from observed stats": "ein Charakteristikum gilt nur beim Ableiten von IVs aus beobachteten Statuswerten",
+	"pokemon is required":                                                  "Pokémon ist erforderlich",
+	"move is required":                                                     "Attacke ist erforderlich",
+	"%s is a status move and deals no damage":                              "%s ist eine Statusattacke und richtet keinen Schaden an",
+	"%s has variable power, pass it in power":                              "%s hat variable Stärke, gib sie in power an",
+	"power must be positive, got %d":                                       "Stärke muss positiv sein, erhalten: %d",
+	"unknown weather %q":                                                   "unbekanntes Wetter %q",
+	"unknown terrain %q":                                                   "unbekanntes Feld %q",
+	"unknown status %q":                                                    "unbekannter Status %q",
+	"current HP must be positive, got %d":                                  "aktuelle KP müssen positiv sein, erhalten: %d",
+	"current HP is above the maximum of %d":                                "aktuelle KP liegen über dem Maximum von %d",
+	"unknown nature %q":                                                    "unbekanntes Wesen %q",
+	"unknown item %q":                                                      "unbekanntes Item %q",
+	"unknown move %q":                                                      "unbekannte Attacke %q",
+	"unknown pokemon %q":                                                   "unbekanntes Pokémon %q",
+	"%s has no base stats":                                                 "%s hat keine Basiswerte",
+
+	// Type matchups
+	"unknown generation":               "unbekannte Generation",
+	"unknown generation %d":            "unbekannte Generation %d",
+	"unknown type":                     "unbekannter Typ",
+	"unknown type %q in generation %d": "unbekannter Typ %q in Generation %d",
+	"unknown move":                     "unbekannte Attacke",
+	"unknown pokemon":                  "unbekanntes Pokémon",
+	"between one and two distinct types are required":   "ein oder zwei verschiedene Typen sind erforderlich",
+	"at least one damaging move or type is required":    "mindestens eine Schadensattacke oder ein Typ ist erforderlich",
+	"at most %d moves and types can be checked at once": "höchstens %d Attacken und Typen können auf einmal geprüft werden",
+	"too many moves and types":                          "zu viele Attacken und Typen",
+	"a team has between 1 and %d pokemon":               "ein Team hat zwischen 1 und %d Pokémon",
+	"invalid team size":                                 "ungültige Teamgröße",
+}
@@ -0,0 +1,141 @@
+package i18n
+
+// spanish translates the API messages, keyed by their English format.
+var spanish = map[string]string{
+	// Generic
+	"Internal server error":      "Error interno del servidor",
+	"Invalid JSON format":        "Formato JSON inválido",
+	"Invalid request":            "Solicitud inválida",
+	"page must be a number":      "page debe ser un número",
+	"page_size must be a number": "page_size debe ser un número",
+	"page must be at least 1 and page_size between 1 and 100": "page debe ser al menos 1 y page_size estar entre 1 y 100",
+	"generation must be a number":                             "generation debe ser un número",
+
+	// Requests
+	"Request body is too large":                     "El cuerpo de la petición es demasiado grande",
+	"request body must be at most %d bytes":         "el cuerpo de la petición debe ocupar como máximo %d bytes",
+	"request body is empty":                         "el cuerpo de la petición está vacío",
+	"request body ends unexpectedly":                "el cuerpo de la petición termina de forma inesperada",
+	"malformed JSON at byte %d":                     "JSON mal formado en el byte %d",
+	"request body must be a JSON object":            "el cuerpo de la petición debe ser un objeto JSON",
+	"request body must contain a single JSON value": "el cuerpo de la petición debe contener un único valor JSON",
+	"unknown field":                                 "campo desconocido",
+	"%s must be of type %s":                         "%s debe ser de tipo %s",
+	"%s is required":                                "%s es obligatorio",
+	"%s must be a valid email address":              "%s debe ser un email válido",
+	"%s must be one of %s":                          "%s debe ser uno de %s",
+	"%s must be at least %d characters long":        "%s debe tener al menos %d caracteres",
+	"%s must be at most %d characters long":         "%s debe tener como máximo %d caracteres",
+	"%s must be at least %d":                        "%s debe ser al menos %d",
+	"%s must be at most %d":                         "%s debe ser como máximo %d",
+	"%s must have at least %d items":                "%s debe tener al menos %d elementos",
+	"%s must have at most %d items":                 "%s debe tener como máximo %d elementos",
+
+	// Authentication
+	"Authorization header required":       "Se requiere la cabecera Authorization",
+	"Invalid Authorization header format": "Formato de la cabecera Authorization inválido",
+	"Invalid token":                       "Token inválido",
+	"Invalid refresh token":               "Refresh token inválido",
+	"Invalid email or password":           "Email o contraseña incorrectos",
+	"Email already exists":                "El email ya existe",
+
+	// Profile
+	"User not found":                     "Usuario no encontrado",
+	"Username already exists":            "El nombre de usuario ya existe",
+	"user ID is required":                "se requiere el ID de usuario",
+	"no updates provided":                "no se indicaron cambios",
+	"no valid updates provided":          "no se indicaron cambios válidos",
+	"field is not allowed for update":    "el campo no se puede modificar",
+	"field %q is not allowed for update": "el campo %q no se puede modificar",
+
+	// Pokédex
+	"Pokemon not found":         "Pokémon no encontrado",
+	"Move not found":            "Movimiento no encontrado",
+	"Ability not found":         "Habilidad no encontrada",
+	"Item not found":            "Objeto no encontrado",
+	"Base stats are not loaded": "Las estadísticas base no están cargadas",
+	"Unknown version":           "Versión desconocida",
+	"damage_class must be physical, special or status": "damage_class debe ser physical, special o status",
+	"holdable must be true or false":                   "holdable debe ser true o false",
+
+	// Search
+	"%s: expected field, operator and value, e.g. type:fire or speed>=100": "%s: se esperaba campo, operador y valor, p. ej. type:fire o speed>=100",
+	"%s: unknown field %q":                                     "%s: campo desconocido %q",
+	"%s: missing value":                                        "%s: falta el valor",
+	"%s: %s only supports : and !=":                            "%s: %s solo admite : y !=",
+	"%s: %s must be compared to a number":                      "%s: %s debe compararse con un número",
+	"%s: %s must be true or false":                             "%s: %s debe ser true o false",
+	"%s: at most %d terms are allowed":                         "%s: se permiten como máximo %d términos",
+	"%s: unterminated quote":                                   "%s: falta cerrar las comillas",
+	"%s: can only sort by id, name, generation, a stat or bst": "%s: solo se puede ordenar por id, name, generation, una estadística o bst",
+	"%s: unknown type %q":                                      "%s: tipo desconocido %q",
+
+	// Collection
+	"species_id must be a number":            "species_id debe ser un número",
+	"form_id must be a number":               "form_id debe ser un número",
+	"species not found":                      "especie no encontrada",
+	"form not found for this species":        "forma no encontrada para esta especie",
+	"unknown game version":                   "versión del juego desconocida",
+	"status must be seen, caught or shiny":   "status debe ser seen, caught o shiny",
+	"marked_at cannot be in the future":      "marked_at no puede estar en el futuro",
+	"at most %d entries can be sent at once": "se pueden enviar como máximo %d entradas a la vez",
+	"too many entries":                       "demasiadas entradas",
+
+	// Teams
+	"Team not found":                         "Equipo no encontrado",
+	"name is required":                       "el nombre es obligatorio",
+	"name must be at most %d characters":     "el nombre debe tener como máximo %d caracteres",
+	"format must be at most %d characters":   "el formato debe tener como máximo %d caracteres",
+	"nickname must be at most %d characters": "el mote debe tener como máximo %d caracteres",
+	"a team has at most %d members":          "un equipo tiene como máximo %d miembros",
+	"a pokemon knows at most %d moves":       "un Pokémon conoce como máximo %d movimientos",
+	"species already used by members[%d]":    "especie ya usada por members[%d]",
+	"ability is required":                    "la habilidad es obligatoria",
+	"%s cannot have the ability %q":          "%s no puede tener la habilidad %q",
+	"move %q is already known":               "ya conoce el movimiento %q",
+	"must be between 0 and %d, got %d":       "debe estar entre 0 y %d, se recibió %d",
+	"the paste contains no pokemon":          "el texto no contiene ningún Pokémon",
+	"missing species":                        "falta la especie",
+	"missing move name":                      "falta el nombre del movimiento",
+	"level must be a number":                 "el nivel debe ser un número",
+	"invalid stat %q":                        "estadística inválida %q",
+	"invalid stat value %q":                  "valor de estadística inválido %q",
+	"unknown stat %q":                        "estadística desconocida %q",
+
+	// Calculators
+	"level must be between 1 and %d":                                       "el nivel debe estar entre 1 y %d",
+	"EVs add up to %d, the maximum is %d":                                  "Los EVs suman %d, el máximo es %d",
+	"must be between %d and %d, got %d":                                    "debe estar entre %d y %d, se recibió %d",
+	"must be at least %d, got %d":                                          "debe ser al menos %d, se recibió %d",
+	"unknown characteristic %q":                                            "característica desconocida %q",
+	"a characteristic only applies when inferring IVs from observed stats": "una característica solo se aplica al deducir IVs a partir de estadísticas observadas",
+	"pokemon is required":                                                  "el Pokémon es obligatorio",
+	"move is required":                                                     "el movimiento es obligatorio",
+	"%s is a status move and deals no damage":                              "%s es un movimiento de estado y no hace daño",
+	"%s has variable power, pass it in power":                              "%s tiene potencia variable, indícala en power",
+	"power must be positive, got %d":                                       "la potencia debe ser positiva, se recibió %d",
+	"unknown weather %q":                                                   "clima desconocido %q",
+	"unknown terrain %q":                                                   "campo desconocido %q",
+	"unknown status %q":                                                    "estado desconocido %q",
+	"current HP must be positive, got %d":                                  "los PS actuales deben ser positivos, se recibió %d",
+	"current HP is above the maximum of %d":                                "los PS actuales superan el máximo de %d",
+	"unknown nature %q":                                                    "naturaleza desconocida %q",
+	"unknown item %q":                                                      "objeto desconocido %q",
+	"unknown move %q":                                                      "movimiento desconocido %q",
+	"unknown pokemon %q":                                                   "Pokémon desconocido %q",
+	"%s has no base stats":                                                 "%s no tiene estadísticas base",
+
+	// Type matchups
+	"unknown generation":               "generación desconocida",
+	"unknown generation %d":            "generación desconocida %d",
+	"unknown type":                     "tipo desconocido",
+	"unknown type %q in generation %d": "tipo desconocido %q en la generación %d",
+	"unknown move":                     "movimiento desconocido",
+	"unknown pokemon":                  "Pokémon desconocido",
+	"between one and two distinct types are required":   "se requieren entre uno y dos tipos distintos",
+	"at least one damaging move or type is required":    "se requiere al menos un movimiento de daño o un tipo",
+	"at most %d moves and types can be checked at once": "se pueden comprobar como máximo %d movimientos y tipos a la vez",
+	"too many moves and types":                          "demasiados movimientos y tipos",
+	"a team has between 1 and %d pokemon":               "un equipo tiene entre 1 y %d Pokémon",
+	"invalid team size":                                 "tamaño de equipo inválido",
+}
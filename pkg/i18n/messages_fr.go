@@ -0,0 +1,141 @@
+package i18n
+
+// french translates the API messages, keyed by their English format.
+var french = map[string]string{
+	// Generic
+	"Internal server error":      "Erreur interne du serveur",
+	"Invalid JSON format":        "Format JSON invalide",
+	"Invalid request":            "Requête invalide",
+	"page must be a number":      "page doit être un nombre",
+	"page_size must be a number": "page_size doit être un nombre",
+	"page must be at least 1 and page_size between 1 and 100": "page doit valoir au moins 1 et page_size être entre 1 et 100",
+	"generation must be a number":                             "generation doit être un nombre",
+
+	// Requests
+	"Request body is too large":                     "Le corps de la requête est trop volumineux",
+	"request body must be at most %d bytes":         "le corps de la requête doit faire au plus %d octets",
+	"request body is empty":                         "le corps de la requête est vide",
+	"request body ends unexpectedly":                "le corps de la requête se termine de façon inattendue",
+	"malformed JSON at byte %d":                     "JSON mal formé à l'octet %d",
+	"request body must be a JSON object":            "le corps de la requête doit être un objet JSON",
+	"request body must contain a single JSON value": "le corps de la requête doit contenir une seule valeur JSON",
+	"unknown field":                                 "champ inconnu",
+	"%s must be of type %s":                         "%s doit être de type %s",
+	"%s is required":                                "%s est obligatoire",
+	"%s must be a valid email address":              "%s doit être une adresse e-mail valide",
+	"%s must be one of %s":                          "%s doit valoir %s",
+	"%s must be at least %d characters long":        "%s doit comporter au moins %d caractères",
+	"%s must be at most %d characters long":         "%s doit comporter au plus %d caractères",
+	"%s must be at least %d":                        "%s doit être au moins %d",
+	"%s must be at most %d":                         "%s doit être au plus %d",
+	"%s must have at least %d items":                "%s doit contenir au moins %d éléments",
+	"%s must have at most %d items":                 "%s doit contenir au plus %d éléments",
+
+	// Authentication
+	"Authorization header required":       "L'en-tête Authorization est requis",
+	"Invalid Authorization header format": "Format de l'en-tête Authorization invalide",
+	"Invalid token":                       "Jeton invalide",
+	"Invalid refresh token":               "Jeton de rafraîchissement invalide",
+	"Invalid email or password":           "E-mail ou mot de passe incorrect",
+	"Email already exists":                "Cet e-mail existe déjà",
+
+	// Profile
+	"User not found":                     "Utilisateur introuvable",
+	"Username already exists":            "Ce nom d'utilisateur existe déjà",
+	"user ID is required":                "l'identifiant de l'utilisateur est requis",
+	"no updates provided":                "aucune modification fournie",
+	"no valid updates provided":          "aucune modification valide fournie",
+	"field is not allowed for update":    "le champ ne peut pas être modifié",
+	"field %q is not allowed for update": "le champ %q ne peut pas être modifié",
+
+	// Pokédex
+	"Pokemon not found":         "Pokémon introuvable",
+	"Move not found":            "Capacité introuvable",
+	"Ability not found":         "Talent introuvable",
+	"Item not found":            "Objet introuvable",
+	"Base stats are not loaded": "Les statistiques de base ne sont pas chargées",
+	"Unknown version":           "Version inconnue",
+	"damage_class must be physical, special or status": "damage_class doit être physical, special ou status",
+	"holdable must be true or false":                   "holdable doit être true ou false",
+
+	// Search
+	"%s: expected field, operator and value, e.g. type:fire or speed>=100": "%s : champ, opérateur et valeur attendus, p. ex. type:fire ou speed>=100",
+	"%s: unknown field %q":                                     "%s : champ inconnu %q",
+	"%s: missing value":                                        "%s : valeur manquante",
+	"%s: %s only supports : and !=":                            "%s : %s n'accepte que : et !=",
+	"%s: %s must be compared to a number":                      "%s : %s doit être comparé à un nombre",
+	"%s: %s must be true or false":                             "%s : %s doit être true ou false",
+	"%s: at most %d terms are allowed":                         "%s : %d termes au maximum sont autorisés",
+	"%s: unterminated quote":                                   "%s: guillemet non fermé",
+	"%s: can only sort by id, name, generation, a stat or bst": "%s : le tri n'est possible que par id, name, generation, une statistique ou bst",
+	"%s: unknown type %q":                                      "%s : type inconnu %q",
+
+	// Collection
+	"species_id must be a number":            "species_id doit être un nombre",
+	"form_id must be a number":               "form_id doit être un nombre",
+	"species not found":                      "espèce introuvable",
+	"form not found for this species":        "forme introuvable pour cette espèce",
+	"unknown game version":                   "version du jeu inconnue",
+	"status must be seen, caught or shiny":   "status doit être seen, caught ou shiny",
+	"marked_at cannot be in the future":      "marked_at ne peut pas être dans le futur",
+	"at most %d entries can be sent at once": "%d entrées au maximum peuvent être envoyées à la fois",
+	"too many entries":                       "trop d'entrées",
+
+	// Teams
+	"Team not found":                         "Équipe introuvable",
+	"name is required":                       "le nom est requis",
+	"name must be at most %d characters":     "le nom doit contenir au plus %d caractères",
+	"format must be at most %d characters":   "le format doit contenir au plus %d caractères",
+	"nickname must be at most %d characters": "le surnom doit contenir au plus %d caractères",
+	"a team has at most %d members":          "une équipe compte au plus %d membres",
+	"a pokemon knows at most %d moves":       "un Pokémon connaît au plus %d capacités",
+	"species already used by members[%d]":    "espèce déjà utilisée par members[%d]",
+	"ability is required":                    "le talent est requis",
+	"%s cannot have the ability %q":          "%s ne peut pas avoir le talent %q",
+	"move %q is already known":               "la capacité %q est déjà connue",
+	"must be between 0 and %d, got %d":       "doit être entre 0 et %d, reçu %d",
+	"the paste contains no pokemon":          "le texte ne contient aucun Pokémon",
+	"missing species":                        "espèce manquante",
+	"missing move name":                      "nom de capacité manquant",
+	"level must be a number":                 "le niveau doit être un nombre",
+	"invalid stat %q":                        "statistique invalide %q",
+	"invalid stat value %q":                  "valeur de statistique invalide %q",
+	"unknown stat %q":                        "statistique inconnue %q",
+
+	// Calculators
+	"level must be between 1 and %d":                                       "le niveau doit être entre 1 et %d",
+	"EVs add up to %d, the maximum is %d":                                  "les EV totalisent %d, le maximum est %d",
+	"must be between %d and %d, got %d":                                    "doit être entre %d et %d, reçu %d",
+	"must be at least %d, got %d":                                          "doit valoir au moins %d, reçu %d",
+	"unknown characteristic %q":                                            "caractéristique inconnue %q",
+	"a characteristic only applies when inferring IVs from observed stats": "une caractéristique ne s'applique que pour déduire les IV à partir de statistiques observées",
+	"pokemon is required":                                                  "le Pokémon est requis",
+	"move is required":                                                     "la capacité est requise",
+	"%s is a status move and deals no damage":                              "%s est une capacité de statut et n'inflige pas de dégâts",
+	"%s has variable power, pass it in power":                              "%s a une puissance variable, indiquez-la dans power",
+	"power must be positive, got %d":                                       "la puissance doit être positive, reçu %d",
+	"unknown weather %q":                                                   "météo inconnue %q",
+	"unknown terrain %q":                                                   "champ inconnu %q",
+	"unknown status %q":                                                    "statut inconnu %q",
+	"current HP must be positive, got %d":                                  "les PV actuels doivent être positifs, reçu %d",
+	"current HP is above the maximum of %d":                                "les PV actuels dépassent le maximum de %d",
+	"unknown nature %q":                                                    "nature inconnue %q",
+	"unknown item %q":                                                      "objet inconnu %q",
+	"unknown move %q":                                                      "capacité inconnue %q",
+	"unknown pokemon %q":                                                   "Pokémon inconnu %q",
+	"%s has no base stats":                                                 "%s n'a pas de statistiques de base",
+
+	// Type matchups
+	"unknown generation":               "génération inconnue",
+	"unknown generation %d":            "génération inconnue %d",
+	"unknown type":                     "type inconnu",
+	"unknown type %q in generation %d": "type inconnu %q dans la génération %d",
+	"unknown move":                     "capacité inconnue",
+	"unknown pokemon":                  "Pokémon inconnu",
+	"between one and two distinct types are required":   "un ou deux types distincts sont requis",
+	"at least one damaging move or type is required":    "au moins une capacité offensive ou un type est requis",
+	"at most %d moves and types can be checked at once": "%d capacités et types au maximum peuvent être vérifiés à la fois",
+	"too many moves and types":                          "trop de capacités et de types",
+	"a team has between 1 and %d pokemon":               "une équipe compte entre 1 et %d Pokémon",
+	"invalid team size":                                 "taille d'équipe invalide",
+}
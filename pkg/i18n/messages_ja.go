@@ -0,0 +1,141 @@
+package i18n
+
+// japanese translates the API messages, keyed by their English format.
+var japanese = map[string]string{
+	// Generic
+	"Internal server error":      "サーバー内部エラー",
+	"Invalid JSON format":        "JSONの形式が正しくありません",
+	"Invalid request":            "リクエストが正しくありません",
+	"page must be a number":      "pageは数値で指定してください",
+	"page_size must be a number": "page_sizeは数値で指定してください",
+	"page must be at least 1 and page_size between 1 and 100": "pageは1以上、page_sizeは1から100の間で指定してください",
+	"generation must be a number":                             "generationは数値で指定してください",
+
+	// Requests
+	"Request body is too large":                     "リクエストボディが大きすぎます",
+	"request body must be at most %d bytes":         "リクエストボディは%dバイト以下にしてください",
+	"request body is empty":                         "リクエストボディが空です",
+	"request body ends unexpectedly":                "リクエストボディが途中で終わっています",
+	"malformed JSON at byte %d":                     "%dバイト目のJSONが正しくありません",
+	"request body must be a JSON object":            "リクエストボディはJSONオブジェクトにしてください",
+	"request body must contain a single JSON value": "リクエストボディにはJSONの値を1つだけ含めてください",
+	"unknown field":                                 "不明な項目です",
+	"%s must be of type %s":                         "%sは%s型で指定してください",
+	"%s is required":                                "%sは必須です",
+	"%s must be a valid email address":              "%sには有効なメールアドレスを指定してください",
+	"%s must be one of %s":                          "%sは%sのいずれかにしてください",
+	"%s must be at least %d characters long":        "%sは%d文字以上にしてください",
+	"%s must be at most %d characters long":         "%sは%d文字以下にしてください",
+	"%s must be at least %d":                        "%sは%d以上にしてください",
+	"%s must be at most %d":                         "%sは%d以下にしてください",
+	"%s must have at least %d items":                "%sには%d個以上の要素が必要です",
+	"%s must have at most %d items":                 "%sの要素は%d個までです",
+
+	// Authentication
+	"Authorization header required":       "Authorizationヘッダーが必要です",
+	"Invalid Authorization header format": "Authorizationヘッダーの形式が正しくありません",
+	"Invalid token":                       "トークンが無効です",
+	"Invalid refresh token":               "リフレッシュトークンが無効です",
+	"Invalid email or password":           "メールアドレスまたはパスワードが正しくありません",
+	"Email already exists":                "このメールアドレスは既に登録されています",
+
+	// Profile
+	"User not found":                     "ユーザーが見つかりません",
+	"Username already exists":            "このユーザー名は既に使われています",
+	"user ID is required":                "ユーザーIDは必須です",
+	"no updates provided":                "変更が指定されていません",
+	"no valid updates provided":          "有効な変更が指定されていません",
+	"field is not allowed for update":    "この項目は変更できません",
+	"field %q is not allowed for update": "項目%qは変更できません",
+
+	// Pokédex
+	"Pokemon not found":         "ポケモンが見つかりません",
+	"Move not found":            "わざが見つかりません",
+	"Ability not found":         "とくせいが見つかりません",
+	"Item not found":            "どうぐが見つかりません",
+	"Base stats are not loaded": "種族値が読み込まれていません",
+	"Unknown version":           "不明なバージョンです",
+	"damage_class must be physical, special or status": "damage_classはphysical、special、statusのいずれかで指定してください",
+	"holdable must be true or false":                   "holdableはtrueかfalseで指定してください",
+
+	// Search
+	"%s: expected field, operator and value, e.g. type:fire or speed>=100": "%s: フィールド、演算子、値を指定してください(例: type:fire、speed>=100)",
+	"%s: unknown field %q":                                     "%s: 不明なフィールド%qです",
+	"%s: missing value":                                        "%s: 値がありません",
+	"%s: %s only supports : and !=":                            "%s: %sで使える演算子は:と!=だけです",
+	"%s: %s must be compared to a number":                      "%s: %sは数値と比較してください",
+	"%s: %s must be true or false":                             "%s: %sはtrueかfalseで指定してください",
+	"%s: at most %d terms are allowed":                         "%s: 条件は%d個までです",
+	"%s: unterminated quote":                                   "%s: 引用符が閉じられていません",
+	"%s: can only sort by id, name, generation, a stat or bst": "%s: 並べ替えはid、name、generation、能力値、bstのいずれかで指定してください",
+	"%s: unknown type %q":                                      "%s: 不明なタイプ%qです",
+
+	// Collection
+	"species_id must be a number":            "species_idは数値で指定してください",
+	"form_id must be a number":               "form_idは数値で指定してください",
+	"species not found":                      "種族が見つかりません",
+	"form not found for this species":        "この種族にそのフォルムはありません",
+	"unknown game version":                   "不明なゲームバージョンです",
+	"status must be seen, caught or shiny":   "statusはseen、caught、shinyのいずれかで指定してください",
+	"marked_at cannot be in the future":      "marked_atに未来の日時は指定できません",
+	"at most %d entries can be sent at once": "一度に送信できるのは%d件までです",
+	"too many entries":                       "件数が多すぎます",
+
+	// Teams
+	"Team not found":                         "チームが見つかりません",
+	"name is required":                       "名前は必須です",
+	"name must be at most %d characters":     "名前は%d文字以内で指定してください",
+	"format must be at most %d characters":   "フォーマットは%d文字以内で指定してください",
+	"nickname must be at most %d characters": "ニックネームは%d文字以内で指定してください",
+	"a team has at most %d members":          "チームのメンバーは%d匹までです",
+	"a pokemon knows at most %d moves":       "ポケモンが覚えられるわざは%d個までです",
+	"species already used by members[%d]":    "この種族は既にmembers[%d]で使われています",
+	"ability is required":                    "とくせいは必須です",
+	"%s cannot have the ability %q":          "%sはとくせい%qを持てません",
+	"move %q is already known":               "わざ%qは既に覚えています",
+	"must be between 0 and %d, got %d":       "0から%dの間で指定してください(指定値: %d)",
+	"the paste contains no pokemon":          "テキストにポケモンが含まれていません",
+	"missing species":                        "種族がありません",
+	"missing move name":                      "わざの名前がありません",
+	"level must be a number":                 "レベルは数値で指定してください",
+	"invalid stat %q":                        "能力値の指定%qが正しくありません",
+	"invalid stat value %q":                  "能力値の数値%qが正しくありません",
+	"unknown stat %q":                        "不明な能力値%qです",
+
+	// Calculators
+	"level must be between 1 and %d":                                       "レベルは1から%dの間で指定してください",
+	"EVs add up to %d, the maximum is %d":                                  "努力値の合計が%dです(上限は%d)",
+	"must be between %d and %d, got %d":                                    "%dから%dの間で指定してください(指定値: %d)",
+	"must be at least %d, got %d":                                          "%d以上で指定してください(指定値: %d)",
+	"unknown characteristic %q":                                            "不明な個性%qです",
+	"a characteristic only applies when inferring IVs from observed stats": "個性は実測値から個体値を推定する場合にのみ指定できます",
+	"pokemon is required":                                                  "ポケモンは必須です",
+	"move is required":                                                     "わざは必須です",
+	"%s is a status move and deals no damage":                              "%sは変化技なのでダメージを与えません",
+	"%s has variable power, pass it in power":                              "%sは威力が変動するため、powerで威力を指定してください",
+	"power must be positive, got %d":                                       "威力は正の数で指定してください(指定値: %d)",
+	"unknown weather %q":                                                   "不明な天気%qです",
+	"unknown terrain %q":                                                   "不明なフィールド%qです",
+	"unknown status %q":                                                    "不明な状態異常%qです",
+	"current HP must be positive, got %d":                                  "現在のHPは正の数で指定してください(指定値: %d)",
+	"current HP is above the maximum of %d":                                "現在のHPが最大値%dを超えています",
+	"unknown nature %q":                                                    "不明な性格%qです",
+	"unknown item %q":                                                      "不明などうぐ%qです",
+	"unknown move %q":                                                      "不明なわざ%qです",
+	"unknown pokemon %q":                                                   "不明なポケモン%qです",
+	"%s has no base stats":                                                 "%sには種族値がありません",
+
+	// Type matchups
+	"unknown generation":               "不明な世代です",
+	"unknown generation %d":            "不明な世代%dです",
+	"unknown type":                     "不明なタイプです",
+	"unknown type %q in generation %d": "第%[2]d世代に存在しないタイプ%[1]qです",
+	"unknown move":                     "不明なわざです",
+	"unknown pokemon":                  "不明なポケモンです",
+	"between one and two distinct types are required":   "異なるタイプを1つか2つ指定してください",
+	"at least one damaging move or type is required":    "攻撃わざかタイプを1つ以上指定してください",
+	"at most %d moves and types can be checked at once": "一度に調べられるわざとタイプは%d個までです",
+	"too many moves and types":                          "わざとタイプが多すぎます",
+	"a team has between 1 and %d pokemon":               "チームのポケモンは1匹から%d匹までです",
+	"invalid team size":                                 "チームの匹数が正しくありません",
+}
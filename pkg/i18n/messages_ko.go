@@ -0,0 +1,141 @@
+package i18n
+
+// korean translates the API messages, keyed by their English format.
+var korean = map[string]string{
+	// Generic
+	"Internal server error":      "서버 내부 오류",
+	"Invalid JSON format":        "JSON 형식이 올바르지 않습니다",
+	"Invalid request":            "요청이 올바르지 않습니다",
+	"page must be a number":      "page는 숫자여야 합니다",
+	"page_size must be a number": "page_size는 숫자여야 합니다",
+	"page must be at least 1 and page_size between 1 and 100": "page는 1 이상, page_size는 1에서 100 사이여야 합니다",
+	"generation must be a number":                             "generation은 숫자여야 합니다",
+
+	// Requests
+	"Request body is too large":                     "요청 본문이 너무 큽니다",
+	"request body must be at most %d bytes":         "요청 본문은 최대 %d바이트까지 가능합니다",
+	"request body is empty":                         "요청 본문이 비어 있습니다",
+	"request body ends unexpectedly":                "요청 본문이 예기치 않게 끝났습니다",
+	"malformed JSON at byte %d":                     "%d바이트 위치의 JSON 형식이 올바르지 않습니다",
+	"request body must be a JSON object":            "요청 본문은 JSON 객체여야 합니다",
+	"request body must contain a single JSON value": "요청 본문에는 JSON 값이 하나만 있어야 합니다",
+	"unknown field":                                 "알 수 없는 필드입니다",
+	"%s must be of type %s":                         "%s은(는) %s 타입이어야 합니다",
+	"%s is required":                                "%s은(는) 필수입니다",
+	"%s must be a valid email address":              "%s은(는) 올바른 이메일 주소여야 합니다",
+	"%s must be one of %s":                          "%s은(는) %s 중 하나여야 합니다",
+	"%s must be at least %d characters long":        "%s은(는) %d자 이상이어야 합니다",
+	"%s must be at most %d characters long":         "%s은(는) %d자 이하여야 합니다",
+	"%s must be at least %d":                        "%s은(는) %d 이상이어야 합니다",
+	"%s must be at most %d":                         "%s은(는) %d 이하여야 합니다",
+	"%s must have at least %d items":                "%s에는 %d개 이상의 항목이 필요합니다",
+	"%s must have at most %d items":                 "%s의 항목은 최대 %d개입니다",
+
+	// Authentication
+	"Authorization header required":       "Authorization 헤더가 필요합니다",
+	"Invalid Authorization header format": "Authorization 헤더 형식이 올바르지 않습니다",
+	"Invalid token":                       "유효하지 않은 토큰입니다",
+	"Invalid refresh token":               "유효하지 않은 리프레시 토큰입니다",
+	"Invalid email or password":           "이메일 또는 비밀번호가 올바르지 않습니다",
+	"Email already exists":                "이미 등록된 이메일입니다",
+
+	// Profile
+	"User not found":                     "사용자를 찾을 수 없습니다",
+	"Username already exists":            "이미 사용 중인 사용자 이름입니다",
+	"user ID is required":                "사용자 ID는 필수입니다",
+	"no updates provided":                "변경 사항이 없습니다",
+	"no valid updates provided":          "유효한 변경 사항이 없습니다",
+	"field is not allowed for update":    "이 필드는 변경할 수 없습니다",
+	"field %q is not allowed for update": "필드 %q은(는) 변경할 수 없습니다",
+
+	// Pokédex
+	"Pokemon not found":         "포켓몬을 찾을 수 없습니다",
+	"Move not found":            "기술을 찾을 수 없습니다",
+	"Ability not found":         "특성을 찾을 수 없습니다",
+	"Item not found":            "도구를 찾을 수 없습니다",
+	"Base stats are not loaded": "종족값이 로드되지 않았습니다",
+	"Unknown version":           "알 수 없는 버전입니다",
+	"damage_class must be physical, special or status": "damage_class는 physical, special, status 중 하나여야 합니다",
+	"holdable must be true or false":                   "holdable은 true 또는 false여야 합니다",
+
+	// Search
+	"%s: expected field, operator and value, e.g. type:fire or speed>=100": "%s: 필드, 연산자, 값이 필요합니다 (예: type:fire, speed>=100)",
+	"%s: unknown field %q":                                     "%s: 알 수 없는 필드 %q",
+	"%s: missing value":                                        "%s: 값이 없습니다",
+	"%s: %s only supports : and !=":                            "%s: %s에는 :와 !=만 사용할 수 있습니다",
+	"%s: %s must be compared to a number":                      "%s: %s는 숫자와 비교해야 합니다",
+	"%s: %s must be true or false":                             "%s: %s는 true 또는 false여야 합니다",
+	"%s: at most %d terms are allowed":                         "%s: 조건은 최대 %d개까지 가능합니다",
+	"%s: unterminated quote":                                   "%s: 따옴표가 닫히지 않았습니다",
+	"%s: can only sort by id, name, generation, a stat or bst": "%s: id, name, generation, 능력치 또는 bst로만 정렬할 수 있습니다",
+	"%s: unknown type %q":                                      "%s: 알 수 없는 타입 %q",
+
+	// Collection
+	"species_id must be a number":            "species_id는 숫자여야 합니다",
+	"form_id must be a number":               "form_id는 숫자여야 합니다",
+	"species not found":                      "종을 찾을 수 없습니다",
+	"form not found for this species":        "이 종에 해당 폼이 없습니다",
+	"unknown game version":                   "알 수 없는 게임 버전입니다",
+	"status must be seen, caught or shiny":   "status는 seen, caught, shiny 중 하나여야 합니다",
+	"marked_at cannot be in the future":      "marked_at은 미래일 수 없습니다",
+	"at most %d entries can be sent at once": "한 번에 최대 %d개까지 보낼 수 있습니다",
+	"too many entries":                       "항목이 너무 많습니다",
+
+	// Teams
+	"Team not found":                         "팀을 찾을 수 없습니다",
+	"name is required":                       "이름은 필수입니다",
+	"name must be at most %d characters":     "이름은 최대 %d자까지 가능합니다",
+	"format must be at most %d characters":   "포맷은 최대 %d자까지 가능합니다",
+	"nickname must be at most %d characters": "닉네임은 최대 %d자까지 가능합니다",
+	"a team has at most %d members":          "팀원은 최대 %d마리까지 가능합니다",
+	"a pokemon knows at most %d moves":       "포켓몬은 기술을 최대 %d개까지 배울 수 있습니다",
+	"species already used by members[%d]":    "이미 members[%d]에서 사용 중인 종입니다",
+	"ability is required":                    "특성은 필수입니다",
+	"%s cannot have the ability %q":          "%s는 특성 %q를 가질 수 없습니다",
+	"move %q is already known":               "기술 %q는 이미 배웠습니다",
+	"must be between 0 and %d, got %d":       "0에서 %d 사이여야 합니다 (입력값: %d)",
+	"the paste contains no pokemon":          "텍스트에 포켓몬이 없습니다",
+	"missing species":                        "종이 없습니다",
+	"missing move name":                      "기술 이름이 없습니다",
+	"level must be a number":                 "레벨은 숫자여야 합니다",
+	"invalid stat %q":                        "올바르지 않은 능력치 %q",
+	"invalid stat value %q":                  "올바르지 않은 능력치 값 %q",
+	"unknown stat %q":                        "알 수 없는 능력치 %q",
+
+	// Calculators
+	"level must be between 1 and %d":                                       "레벨은 1에서 %d 사이여야 합니다",
+	"EVs add up to %d, the maximum is %d":                                  "노력치 합계가 %d입니다 (최대 %d)",
+	"must be between %d and %d, got %d":                                    "%d에서 %d 사이여야 합니다 (입력값: %d)",
+	"must be at least %d, got %d":                                          "%d 이상이어야 합니다 (입력값: %d)",
+	"unknown characteristic %q":                                            "알 수 없는 개성 %q",
+	"a characteristic only applies when inferring IVs from observed stats": "개성은 실측 능력치로 개체값을 추정할 때만 사용할 수 있습니다",
+	"pokemon is required":                                                  "포켓몬은 필수입니다",
+	"move is required":                                                     "기술은 필수입니다",
+	"%s is a status move and deals no damage":                              "%s는 변화 기술이라 데미지를 주지 않습니다",
+	"%s has variable power, pass it in power":                              "%s는 위력이 변하므로 power에 위력을 지정하세요",
+	"power must be positive, got %d":                                       "위력은 양수여야 합니다 (입력값: %d)",
+	"unknown weather %q":                                                   "알 수 없는 날씨 %q",
+	"unknown terrain %q":                                                   "알 수 없는 필드 %q",
+	"unknown status %q":                                                    "알 수 없는 상태 이상 %q",
+	"current HP must be positive, got %d":                                  "현재 HP는 양수여야 합니다 (입력값: %d)",
+	"current HP is above the maximum of %d":                                "현재 HP가 최대치 %d를 넘습니다",
+	"unknown nature %q":                                                    "알 수 없는 성격 %q",
+	"unknown item %q":                                                      "알 수 없는 도구 %q",
+	"unknown move %q":                                                      "알 수 없는 기술 %q",
+	"unknown pokemon %q":                                                   "알 수 없는 포켓몬 %q",
+	"%s has no base stats":                                                 "%s에는 종족값이 없습니다",
+
+	// Type matchups
+	"unknown generation":               "알 수 없는 세대입니다",
+	"unknown generation %d":            "알 수 없는 세대 %d",
+	"unknown type":                     "알 수 없는 타입입니다",
+	"unknown type %q in generation %d": "%[2]d세대에 없는 타입 %[1]q",
+	"unknown move":                     "알 수 없는 기술입니다",
+	"unknown pokemon":                  "알 수 없는 포켓몬입니다",
+	"between one and two distinct types are required":   "서로 다른 타입을 1개 또는 2개 지정해야 합니다",
+	"at least one damaging move or type is required":    "공격 기술이나 타입을 하나 이상 지정해야 합니다",
+	"at most %d moves and types can be checked at once": "한 번에 최대 %d개의 기술과 타입을 확인할 수 있습니다",
+	"too many moves and types":                          "기술과 타입이 너무 많습니다",
+	"a team has between 1 and %d pokemon":               "팀의 포켓몬은 1마리에서 %d마리까지입니다",
+	"invalid team size":                                 "팀 크기가 올바르지 않습니다",
+}
@@ -0,0 +1,142 @@
+package i18n
+
+// simplifiedChinese translates the API messages, keyed by their English
+// format.
+var simplifiedChinese = map[string]string{
+	// Generic
+	"Internal server error":      "服务器内部错误",
+	"Invalid JSON format":        "JSON 格式无效",
+	"Invalid request":            "请求无效",
+	"page must be a number":      "page 必须是数字",
+	"page_size must be a number": "page_size 必须是数字",
+	"page must be at least 1 and page_size between 1 and 100": "page 至少为 1，page_size 必须在 1 到 100 之间",
+	"generation must be a number":                             "generation 必须是数字",
+
+	// Requests
+	"Request body is too large":                     "请求体过大",
+	"request body must be at most %d bytes":         "请求体最多 %d 字节",
+	"request body is empty":                         "请求体为空",
+	"request body ends unexpectedly":                "请求体意外结束",
+	"malformed JSON at byte %d":                     "第 %d 字节处的 JSON 格式错误",
+	"request body must be a JSON object":            "请求体必须是 JSON 对象",
+	"request body must contain a single JSON value": "请求体只能包含一个 JSON 值",
+	"unknown field":                                 "未知字段",
+	"%s must be of type %s":                         "%s 必须是 %s 类型",
+	"%s is required":                                "%s 为必填项",
+	"%s must be a valid email address":              "%s 必须是有效的邮箱地址",
+	"%s must be one of %s":                          "%s 必须是 %s 之一",
+	"%s must be at least %d characters long":        "%s 至少需要 %d 个字符",
+	"%s must be at most %d characters long":         "%s 最多 %d 个字符",
+	"%s must be at least %d":                        "%s 不能小于 %d",
+	"%s must be at most %d":                         "%s 不能大于 %d",
+	"%s must have at least %d items":                "%s 至少需要 %d 项",
+	"%s must have at most %d items":                 "%s 最多 %d 项",
+
+	// Authentication
+	"Authorization header required":       "缺少 Authorization 请求头",
+	"Invalid Authorization header format": "Authorization 请求头格式无效",
+	"Invalid token":                       "令牌无效",
+	"Invalid refresh token":               "刷新令牌无效",
+	"Invalid email or password":           "邮箱或密码错误",
+	"Email already exists":                "邮箱已存在",
+
+	// Profile
+	"User not found":                     "未找到用户",
+	"Username already exists":            "用户名已存在",
+	"user ID is required":                "用户 ID 为必填项",
+	"no updates provided":                "未提供任何修改",
+	"no valid updates provided":          "未提供有效的修改",
+	"field is not allowed for update":    "该字段不允许修改",
+	"field %q is not allowed for update": "字段 %q 不允许修改",
+
+	// Pokédex
+	"Pokemon not found":         "未找到宝可梦",
+	"Move not found":            "未找到招式",
+	"Ability not found":         "未找到特性",
+	"Item not found":            "未找到道具",
+	"Base stats are not loaded": "种族值尚未加载",
+	"Unknown version":           "未知版本",
+	"damage_class must be physical, special or status": "damage_class 必须是 physical、special 或 status",
+	"holdable must be true or false":                   "holdable 必须是 true 或 false",
+
+	// Search
+	"%s: expected field, operator and value, e.g. type:fire or speed>=100": "%s：需要字段、运算符和值，例如 type:fire 或 speed>=100",
+	"%s: unknown field %q":                                     "%s：未知字段 %q",
+	"%s: missing value":                                        "%s：缺少值",
+	"%s: %s only supports : and !=":                            "%s：%s 只支持 : 和 !=",
+	"%s: %s must be compared to a number":                      "%s：%s 必须与数字比较",
+	"%s: %s must be true or false":                             "%s：%s 必须是 true 或 false",
+	"%s: at most %d terms are allowed":                         "%s：最多允许 %d 个条件",
+	"%s: unterminated quote":                                   "%s: 引号未闭合",
+	"%s: can only sort by id, name, generation, a stat or bst": "%s：只能按 id、name、generation、能力值或 bst 排序",
+	"%s: unknown type %q":                                      "%s：未知属性 %q",
+
+	// Collection
+	"species_id must be a number":            "species_id 必须是数字",
+	"form_id must be a number":               "form_id 必须是数字",
+	"species not found":                      "未找到种类",
+	"form not found for this species":        "该种类没有此形态",
+	"unknown game version":                   "未知游戏版本",
+	"status must be seen, caught or shiny":   "status 必须是 seen、caught 或 shiny",
+	"marked_at cannot be in the future":      "marked_at 不能是未来的时间",
+	"at most %d entries can be sent at once": "一次最多只能发送 %d 条记录",
+	"too many entries":                       "记录过多",
+
+	// Teams
+	"Team not found":                         "未找到队伍",
+	"name is required":                       "名称为必填项",
+	"name must be at most %d characters":     "名称最多 %d 个字符",
+	"format must be at most %d characters":   "格式最多 %d 个字符",
+	"nickname must be at most %d characters": "昵称最多 %d 个字符",
+	"a team has at most %d members":          "一支队伍最多 %d 名成员",
+	"a pokemon knows at most %d moves":       "一只宝可梦最多学会 %d 个招式",
+	"species already used by members[%d]":    "该种类已被 members[%d] 使用",
+	"ability is required":                    "特性为必填项",
+	"%s cannot have the ability %q":          "%s 不能拥有特性 %q",
+	"move %q is already known":               "已经学会招式 %q",
+	"must be between 0 and %d, got %d":       "必须在 0 到 %d 之间，实际为 %d",
+	"the paste contains no pokemon":          "文本中没有宝可梦",
+	"missing species":                        "缺少种类",
+	"missing move name":                      "缺少招式名称",
+	"level must be a number":                 "等级必须是数字",
+	"invalid stat %q":                        "无效的能力值 %q",
+	"invalid stat value %q":                  "无效的能力值数值 %q",
+	"unknown stat %q":                        "未知能力值 %q",
+
+	// Calculators
+	"level must be between 1 and %d":                                       "等级必须在 1 到 %d 之间",
+	"EVs add up to %d, the maximum is %d":                                  "努力值合计为 %d，上限为 %d",
+	"must be between %d and %d, got %d":                                    "必须在 %d 到 %d 之间，实际为 %d",
+	"must be at least %d, got %d":                                          "至少为 %d，实际为 %d",
+	"unknown characteristic %q":                                            "未知个性 %q",
+	"a characteristic only applies when inferring IVs from observed stats": "个性只在根据实测能力值推算个体值时适用",
+	"pokemon is required":                                                  "宝可梦为必填项",
+	"move is required":                                                     "招式为必填项",
+	"%s is a status move and deals no damage":                              "%s 是变化招式，不会造成伤害",
+	"%s has variable power, pass it in power":                              "%s 的威力会变化，请在 power 中指定",
+	"power must be positive, got %d":                                       "威力必须为正数，实际为 %d",
+	"unknown weather %q":                                                   "未知天气 %q",
+	"unknown terrain %q":                                                   "未知场地 %q",
+	"unknown status %q":                                                    "未知异常状态 %q",
+	"current HP must be positive, got %d":                                  "当前 HP 必须为正数，实际为 %d",
+	"current HP is above the maximum of %d":                                "当前 HP 超过了上限 %d",
+	"unknown nature %q":                                                    "未知性格 %q",
+	"unknown item %q":                                                      "未知道具 %q",
+	"unknown move %q":                                                      "未知招式 %q",
+	"unknown pokemon %q":                                                   "未知宝可梦 %q",
+	"%s has no base stats":                                                 "%s 没有种族值",
+
+	// Type matchups
+	"unknown generation":               "未知世代",
+	"unknown generation %d":            "未知世代 %d",
+	"unknown type":                     "未知属性",
+	"unknown type %q in generation %d": "第 %[2]d 世代中没有属性 %[1]q",
+	"unknown move":                     "未知招式",
+	"unknown pokemon":                  "未知宝可梦",
+	"between one and two distinct types are required":   "需要一到两个不同的属性",
+	"at least one damaging move or type is required":    "至少需要一个攻击招式或属性",
+	"at most %d moves and types can be checked at once": "一次最多检查 %d 个招式和属性",
+	"too many moves and types":                          "招式和属性过多",
+	"a team has between 1 and %d pokemon":               "一支队伍有 1 到 %d 只宝可梦",
+	"invalid team size":                                 "队伍数量无效",
+}
@@ -0,0 +1,142 @@
+package i18n
+
+// traditionalChinese translates the API messages, keyed by their English
+// format.
+var traditionalChinese = map[string]string{
+	// Generic
+	"Internal server error":      "伺服器內部錯誤",
+	"Invalid JSON format":        "JSON 格式無效",
+	"Invalid request":            "請求無效",
+	"page must be a number":      "page 必須是數字",
+	"page_size must be a number": "page_size 必須是數字",
+	"page must be at least 1 and page_size between 1 and 100": "page 至少為 1，page_size 必須介於 1 到 100 之間",
+	"generation must be a number":                             "generation 必須是數字",
+
+	// Requests
+	"Request body is too large":                     "請求內容過大",
+	"request body must be at most %d bytes":         "請求內容最多 %d 位元組",
+	"request body is empty":                         "請求內容為空",
+	"request body ends unexpectedly":                "請求內容意外結束",
+	"malformed JSON at byte %d":                     "第 %d 位元組處的 JSON 格式錯誤",
+	"request body must be a JSON object":            "請求內容必須是 JSON 物件",
+	"request body must contain a single JSON value": "請求內容只能包含一個 JSON 值",
+	"unknown field":                                 "未知欄位",
+	"%s must be of type %s":                         "%s 必須是 %s 型別",
+	"%s is required":                                "%s 為必填",
+	"%s must be a valid email address":              "%s 必須是有效的電子郵件地址",
+	"%s must be one of %s":                          "%s 必須是 %s 之一",
+	"%s must be at least %d characters long":        "%s 至少需要 %d 個字元",
+	"%s must be at most %d characters long":         "%s 最多 %d 個字元",
+	"%s must be at least %d":                        "%s 不能小於 %d",
+	"%s must be at most %d":                         "%s 不能大於 %d",
+	"%s must have at least %d items":                "%s 至少需要 %d 項",
+	"%s must have at most %d items":                 "%s 最多 %d 項",
+
+	// Authentication
+	"Authorization header required":       "缺少 Authorization 標頭",
+	"Invalid Authorization header format": "Authorization 標頭格式無效",
+	"Invalid token":                       "權杖無效",
+	"Invalid refresh token":               "更新權杖無效",
+	"Invalid email or password":           "電子郵件或密碼錯誤",
+	"Email already exists":                "電子郵件已存在",
+
+	// Profile
+	"User not found":                     "找不到使用者",
+	"Username already exists":            "使用者名稱已存在",
+	"user ID is required":                "使用者 ID 為必填",
+	"no updates provided":                "未提供任何變更",
+	"no valid updates provided":          "未提供有效的變更",
+	"field is not allowed for update":    "該欄位不允許變更",
+	"field %q is not allowed for update": "欄位 %q 不允許變更",
+
+	// Pokédex
+	"Pokemon not found":         "找不到寶可夢",
+	"Move not found":            "找不到招式",
+	"Ability not found":         "找不到特性",
+	"Item not found":            "找不到道具",
+	"Base stats are not loaded": "種族值尚未載入",
+	"Unknown version":           "未知的版本",
+	"damage_class must be physical, special or status": "damage_class 必須是 physical、special 或 status",
+	"holdable must be true or false":                   "holdable 必須是 true 或 false",
+
+	// Search
+	"%s: expected field, operator and value, e.g. type:fire or speed>=100": "%s：需要欄位、運算子和值，例如 type:fire 或 speed>=100",
+	"%s: unknown field %q":                                     "%s：未知的欄位 %q",
+	"%s: missing value":                                        "%s：缺少值",
+	"%s: %s only supports : and !=":                            "%s：%s 只支援 : 和 !=",
+	"%s: %s must be compared to a number":                      "%s：%s 必須與數字比較",
+	"%s: %s must be true or false":                             "%s：%s 必須是 true 或 false",
+	"%s: at most %d terms are allowed":                         "%s：最多允許 %d 個條件",
+	"%s: unterminated quote":                                   "%s: 引號未閉合",
+	"%s: can only sort by id, name, generation, a stat or bst": "%s：只能依 id、name、generation、能力值或 bst 排序",
+	"%s: unknown type %q":                                      "%s：未知的屬性 %q",
+
+	// Collection
+	"species_id must be a number":            "species_id 必須是數字",
+	"form_id must be a number":               "form_id 必須是數字",
+	"species not found":                      "找不到種類",
+	"form not found for this species":        "此種類沒有該形態",
+	"unknown game version":                   "未知的遊戲版本",
+	"status must be seen, caught or shiny":   "status 必須是 seen、caught 或 shiny",
+	"marked_at cannot be in the future":      "marked_at 不能是未來的時間",
+	"at most %d entries can be sent at once": "一次最多只能傳送 %d 筆資料",
+	"too many entries":                       "資料過多",
+
+	// Teams
+	"Team not found":                         "找不到隊伍",
+	"name is required":                       "名稱為必填",
+	"name must be at most %d characters":     "名稱最多 %d 個字元",
+	"format must be at most %d characters":   "格式最多 %d 個字元",
+	"nickname must be at most %d characters": "暱稱最多 %d 個字元",
+	"a team has at most %d members":          "一支隊伍最多 %d 名成員",
+	"a pokemon knows at most %d moves":       "一隻寶可夢最多學會 %d 個招式",
+	"species already used by members[%d]":    "此種類已被 members[%d] 使用",
+	"ability is required":                    "特性為必填",
+	"%s cannot have the ability %q":          "%s 不能擁有特性 %q",
+	"move %q is already known":               "已經學會招式 %q",
+	"must be between 0 and %d, got %d":       "必須介於 0 到 %d 之間，實際為 %d",
+	"the paste contains no pokemon":          "文字中沒有寶可夢",
+	"missing species":                        "缺少種類",
+	"missing move name":                      "缺少招式名稱",
+	"level must be a number":                 "等級必須是數字",
+	"invalid stat %q":                        "無效的能力值 %q",
+	"invalid stat value %q":                  "無效的能力值數值 %q",
+	"unknown stat %q":                        "未知的能力值 %q",
+
+	// Calculators
+	"level must be between 1 and %d":                                       "等級必須介於 1 到 %d 之間",
+	"EVs add up to %d, the maximum is %d":                                  "努力值合計為 %d，上限為 %d",
+	"must be between %d and %d, got %d":                                    "必須介於 %d 到 %d 之間，實際為 %d",
+	"must be at least %d, got %d":                                          "至少為 %d，實際為 %d",
+	"unknown characteristic %q":                                            "未知的個性 %q",
+	"a characteristic only applies when inferring IVs from observed stats": "個性只在根據實測能力值推算個體值時適用",
+	"pokemon is required":                                                  "寶可夢為必填",
+	"move is required":                                                     "招式為必填",
+	"%s is a status move and deals no damage":                              "%s 是變化招式，不會造成傷害",
+	"%s has variable power, pass it in power":                              "%s 的威力會變化，請在 power 中指定",
+	"power must be positive, got %d":                                       "威力必須為正數，實際為 %d",
+	"unknown weather %q":                                                   "未知的天氣 %q",
+	"unknown terrain %q":                                                   "未知的場地 %q",
+	"unknown status %q":                                                    "未知的異常狀態 %q",
+	"current HP must be positive, got %d":                                  "目前 HP 必須為正數，實際為 %d",
+	"current HP is above the maximum of %d":                                "目前 HP 超過上限 %d",
+	"unknown nature %q":                                                    "未知的性格 %q",
+	"unknown item %q":                                                      "未知的道具 %q",
+	"unknown move %q":                                                      "未知的招式 %q",
+	"unknown pokemon %q":                                                   "未知的寶可夢 %q",
+	"%s has no base stats":                                                 "%s 沒有種族值",
+
+	// Type matchups
+	"unknown generation":               "未知的世代",
+	"unknown generation %d":            "未知的世代 %d",
+	"unknown type":                     "未知的屬性",
+	"unknown type %q in generation %d": "第 %[2]d 世代中沒有屬性 %[1]q",
+	"unknown move":                     "未知的招式",
+	"unknown pokemon":                  "未知的寶可夢",
+	"between one and two distinct types are required":   "需要一到兩個不同的屬性",
+	"at least one damaging move or type is required":    "至少需要一個攻擊招式或屬性",
+	"at most %d moves and types can be checked at once": "一次最多檢查 %d 個招式和屬性",
+	"too many moves and types":                          "招式和屬性過多",
+	"a team has between 1 and %d pokemon":               "一支隊伍有 1 到 %d 隻寶可夢",
+	"invalid team size":                                 "隊伍數量無效",
+}
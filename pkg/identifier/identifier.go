@@ -0,0 +1,29 @@
+// Package identifier converts display names into the PokeAPI identifiers the
+// catalog is keyed by.
+package identifier
+
+import "strings"
+
+var replacer = strings.NewReplacer(
+	" ", "-",
+	"_", "-",
+	"'", "",
+	"’", "",
+	".", "",
+	":", "",
+	"é", "e",
+	"♀", "-f",
+	"♂", "-m",
+)
+
+// Normalize turns a display name such as "Farfetch’d" or "Choice Band" into
+// its identifier ("farfetchd", "choice-band"). Identifiers are returned
+// unchanged.
+func Normalize(name string) string {
+	name = replacer.Replace(strings.ToLower(strings.TrimSpace(name)))
+	for strings.Contains(name, "--") {
+		name = strings.ReplaceAll(name, "--", "-")
+	}
+
+	return strings.Trim(name, "-")
+}
@@ -0,0 +1,130 @@
+package importer
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+var errFileNotFound = errors.New("file not found")
+
+// record is a CSV row accessed by column name, so the importer keeps working
+// when PokeAPI adds or reorders columns in its dump.
+type record struct {
+	file    string
+	line    int
+	columns map[string]int
+	values  []string
+	err     error
+}
+
+func (r *record) fail(column string, err error) {
+	if r.err == nil {
+		r.err = fmt.Errorf("%s:%d: column %q: %w", r.file, r.line, column, err)
+	}
+}
+
+// Err returns the first conversion error of the row, so callers can check it
+// before keeping the values they read.
+func (r *record) Err() error {
+	return r.err
+}
+
+func (r *record) value(column string) string {
+	index, ok := r.columns[column]
+	if !ok {
+		r.fail(column, errors.New("missing column"))
+		return ""
+	}
+
+	if index >= len(r.values) {
+		return ""
+	}
+
+	return r.values[index]
+}
+
+func (r *record) String(column string) string {
+	return r.value(column)
+}
+
+func (r *record) Int(column string) int {
+	value := r.value(column)
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		r.fail(column, err)
+	}
+
+	return n
+}
+
+// OptionalInt returns nil for empty cells, which PokeAPI uses for NULL.
+func (r *record) OptionalInt(column string) *int {
+	value := r.value(column)
+	if value == "" {
+		return nil
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		r.fail(column, err)
+		return nil
+	}
+
+	return &n
+}
+
+func (r *record) Bool(column string) bool {
+	return r.Int(column) != 0
+}
+
+// readCSV calls fn for every row of the file. Any error aborts the import; a
+// missing file is reported as errFileNotFound.
+func readCSV(dir, name string, fn func(r *record) error) error {
+	path := filepath.Join(dir, name)
+
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("%s: %w", name, errFileNotFound)
+		}
+		return err
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return fmt.Errorf("%s: failed to read header: %w", name, err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		columns[column] = i
+	}
+
+	for line := 2; ; line++ {
+		values, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%s:%d: %w", name, line, err)
+		}
+
+		row := &record{file: name, line: line, columns: columns, values: values}
+		if err := fn(row); err != nil {
+			return err
+		}
+
+		if row.err != nil {
+			return row.err
+		}
+	}
+}
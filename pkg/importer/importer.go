@@ -0,0 +1,126 @@
+package importer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"pokedex_backend_go/pkg/database"
+
+	"go.uber.org/zap"
+)
+
+var errDryRun = errors.New("dry run")
+
+type TableStats struct {
+	Table    string
+	Inserted int
+	Updated  int
+	Skipped  int
+	Deleted  int
+}
+
+type Summary struct {
+	DryRun bool
+	Tables []*TableStats
+}
+
+func (s *Summary) table(name string) *TableStats {
+	stats := &TableStats{Table: name}
+	s.Tables = append(s.Tables, stats)
+	return stats
+}
+
+func (s *Summary) Print(w io.Writer) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "table\tinserted\tupdated\tskipped\tdeleted\t")
+	for _, t := range s.Tables {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%d\t\n", t.Table, t.Inserted, t.Updated, t.Skipped, t.Deleted)
+	}
+	tw.Flush()
+
+	if s.DryRun {
+		fmt.Fprintln(w, "dry run: no changes were written")
+	}
+}
+
+type Options struct {
+	// Dir is the directory holding the PokeAPI CSV files (data/v2/csv in the
+	// PokeAPI repository).
+	Dir    string
+	DryRun bool
+}
+
+type step struct {
+	name string
+	run  func(ctx context.Context, imp *importer) error
+}
+
+type importer struct {
+	dir     string
+	logger  *zap.Logger
+	summary *Summary
+	// ids of the rows imported so far, used to skip rows referencing
+	// entities that are missing from the dump instead of failing on the
+	// foreign key.
+	types             map[int]bool
+	abilities         map[int]bool
+	items             map[int]bool
+	evolutionChains   map[int]bool
+	evolutionTriggers map[int]bool
+	locations         map[int]bool
+	versionGroups     map[int]bool
+	species           map[int]bool
+	eggGroups         map[int]bool
+	pokemon           map[int]bool
+	moves             map[int]bool
+	learnMethods      map[int]bool
+	pokedexes         map[int]bool
+}
+
+// Run imports the dump in a single transaction, so a failure leaves the
+// database untouched. In dry-run mode the transaction is rolled back after
+// every statement has run, which reports exactly what would change.
+func Run(ctx context.Context, opts Options) (*Summary, error) {
+	imp := &importer{
+		dir:               opts.Dir,
+		logger:            zap.L().Named("importer"),
+		summary:           &Summary{DryRun: opts.DryRun},
+		types:             make(map[int]bool),
+		abilities:         make(map[int]bool),
+		items:             make(map[int]bool),
+		evolutionChains:   make(map[int]bool),
+		evolutionTriggers: make(map[int]bool),
+		locations:         make(map[int]bool),
+		versionGroups:     make(map[int]bool),
+		species:           make(map[int]bool),
+		eggGroups:         make(map[int]bool),
+		pokemon:           make(map[int]bool),
+		moves:             make(map[int]bool),
+		learnMethods:      make(map[int]bool),
+		pokedexes:         make(map[int]bool),
+	}
+
+	err := database.Transactional(ctx, func(ctx context.Context) error {
+		for _, s := range steps {
+			imp.logger.Info("Importing", zap.String("step", s.name))
+			if err := s.run(ctx, imp); err != nil {
+				return fmt.Errorf("%s: %w", s.name, err)
+			}
+		}
+
+		if opts.DryRun {
+			return errDryRun
+		}
+
+		return nil
+	})
+	if err != nil && !errors.Is(err, errDryRun) {
+		imp.logger.Error("Import failed", zap.Error(err))
+		return nil, err
+	}
+
+	return imp.summary, nil
+}
@@ -0,0 +1,59 @@
+package importer
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// languageEnglish is the PokeAPI id of English, from languages.csv.
+const languageEnglish = 9
+
+type prose struct {
+	shortEffect string
+	effect      string
+}
+
+// readProse reads the English effect texts of a *_prose.csv file, keyed by
+// the id in idColumn.
+func readProse(dir, name, idColumn string) (map[int]prose, error) {
+	texts := make(map[int]prose)
+	err := readCSV(dir, name, func(r *record) error {
+		id := r.Int(idColumn)
+		language := r.Int("local_language_id")
+		text := prose{shortEffect: r.String("short_effect"), effect: r.String("effect")}
+		if err := r.Err(); err != nil {
+			return err
+		}
+
+		if language == languageEnglish {
+			texts[id] = text
+		}
+		return nil
+	})
+
+	return texts, err
+}
+
+// markup matches PokeAPI links such as [Poison]{type:poison} or
+// []{mechanic:special-attack}, whose label is empty when it is the linked
+// entity's name.
+var markup = regexp.MustCompile(`\[([^\]]*)\]\{[a-z-]+:([a-z0-9-]+)\}`)
+
+// cleanProse strips the link markup and fills in $effect_chance, so clients
+// get plain text.
+func cleanProse(text string, effectChance *int) string {
+	text = markup.ReplaceAllStringFunc(text, func(link string) string {
+		parts := markup.FindStringSubmatch(link)
+		if parts[1] != "" {
+			return parts[1]
+		}
+		return strings.ReplaceAll(parts[2], "-", " ")
+	})
+
+	if effectChance != nil {
+		text = strings.ReplaceAll(text, "$effect_chance", strconv.Itoa(*effectChance))
+	}
+
+	return strings.TrimSpace(text)
+}
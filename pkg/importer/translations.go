@@ -0,0 +1,262 @@
+package importer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"pokedex_backend_go/pkg/model"
+)
+
+// languages maps the PokeAPI language ids of languages.csv to the codes
+// translations are stored under. Japanese comes both in kana only
+// (ja-Hrkt, 1) and with kanji (ja, 11); the latter wins when both exist.
+var languages = map[int]string{
+	1:  "ja",
+	3:  "ko",
+	4:  "zh-Hant",
+	5:  "fr",
+	6:  "de",
+	7:  "es",
+	9:  "en",
+	11: "ja",
+	12: "zh-Hans",
+}
+
+const languageKana = 1
+
+type translationKey struct {
+	id       int
+	language string
+}
+
+type translation struct {
+	name       string
+	genus      string
+	flavorText string
+	// nameLanguage and flavorVersion record where the values came from, to
+	// pick kanji over kana and the newest flavor text.
+	nameLanguage  int
+	flavorVersion int
+}
+
+type translations map[translationKey]*translation
+
+func (t translations) get(id int, language string) *translation {
+	key := translationKey{id: id, language: language}
+	if t[key] == nil {
+		t[key] = &translation{}
+	}
+
+	return t[key]
+}
+
+// sorted returns the keys by id and language, so rows are written in a
+// stable order.
+func (t translations) sorted() []translationKey {
+	keys := make([]translationKey, 0, len(t))
+	for key := range t {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].id != keys[j].id {
+			return keys[i].id < keys[j].id
+		}
+		return keys[i].language < keys[j].language
+	})
+
+	return keys
+}
+
+// translationFiles names the PokeAPI files and columns of an entity's
+// translations. genusColumn is empty for entities without a genus.
+type translationFiles struct {
+	names         string
+	namesID       string
+	genusColumn   string
+	flavor        string
+	flavorID      string
+	flavorVersion string
+}
+
+func readTranslations(dir string, files translationFiles) (translations, error) {
+	t := make(translations)
+
+	err := readCSV(dir, files.names, func(r *record) error {
+		id := r.Int(files.namesID)
+		languageID := r.Int("local_language_id")
+		name := r.String("name")
+		genus := ""
+		if files.genusColumn != "" {
+			genus = r.String(files.genusColumn)
+		}
+		if err := r.Err(); err != nil {
+			return err
+		}
+
+		language, ok := languages[languageID]
+		if !ok {
+			return nil
+		}
+
+		entry := t.get(id, language)
+		if entry.nameLanguage != 0 && languageID == languageKana {
+			return nil
+		}
+		entry.name, entry.genus, entry.nameLanguage = name, genus, languageID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = readCSV(dir, files.flavor, func(r *record) error {
+		id := r.Int(files.flavorID)
+		languageID := r.Int("language_id")
+		version := r.Int(files.flavorVersion)
+		text := r.String("flavor_text")
+		if err := r.Err(); err != nil {
+			return err
+		}
+
+		language, ok := languages[languageID]
+		if !ok {
+			return nil
+		}
+
+		entry := t.get(id, language)
+		if version < entry.flavorVersion {
+			return nil
+		}
+		entry.flavorText, entry.flavorVersion = cleanFlavorText(text), version
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// cleanFlavorText joins the lines of a flavor text, which keeps the line
+// and page breaks of the game's text box.
+func cleanFlavorText(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
+
+func importSpeciesTranslations(ctx context.Context, imp *importer) error {
+	stats := imp.summary.table("species_translations")
+
+	t, err := readTranslations(imp.dir, translationFiles{
+		names:         "pokemon_species_names.csv",
+		namesID:       "pokemon_species_id",
+		genusColumn:   "genus",
+		flavor:        "pokemon_species_flavor_text.csv",
+		flavorID:      "species_id",
+		flavorVersion: "version_id",
+	})
+	if err != nil {
+		return err
+	}
+
+	var rows []model.SpeciesTranslation
+	for _, key := range t.sorted() {
+		if !imp.species[key.id] {
+			stats.Skipped++
+			continue
+		}
+		entry := t[key]
+		rows = append(rows, model.SpeciesTranslation{
+			SpeciesID:  key.id,
+			Language:   key.language,
+			Name:       entry.name,
+			Genus:      entry.genus,
+			FlavorText: entry.flavorText,
+		})
+	}
+
+	return upsert(ctx, imp.logger, table[model.SpeciesTranslation]{
+		name:     "species_translations",
+		conflict: []string{"species_id", "language"},
+		update:   []string{"name", "genus", "flavor_text"},
+		key:      func(s *model.SpeciesTranslation) string { return fmt.Sprintf("%d/%s", s.SpeciesID, s.Language) },
+		prune:    true,
+	}, rows, stats)
+}
+
+func importMoveTranslations(ctx context.Context, imp *importer) error {
+	stats := imp.summary.table("move_translations")
+
+	t, err := readTranslations(imp.dir, translationFiles{
+		names:         "move_names.csv",
+		namesID:       "move_id",
+		flavor:        "move_flavor_text.csv",
+		flavorID:      "move_id",
+		flavorVersion: "version_group_id",
+	})
+	if err != nil {
+		return err
+	}
+
+	var rows []model.MoveTranslation
+	for _, key := range t.sorted() {
+		if !imp.moves[key.id] {
+			stats.Skipped++
+			continue
+		}
+		entry := t[key]
+		rows = append(rows, model.MoveTranslation{
+			MoveID:     key.id,
+			Language:   key.language,
+			Name:       entry.name,
+			FlavorText: entry.flavorText,
+		})
+	}
+
+	return upsert(ctx, imp.logger, table[model.MoveTranslation]{
+		name:     "move_translations",
+		conflict: []string{"move_id", "language"},
+		update:   []string{"name", "flavor_text"},
+		key:      func(m *model.MoveTranslation) string { return fmt.Sprintf("%d/%s", m.MoveID, m.Language) },
+		prune:    true,
+	}, rows, stats)
+}
+
+func importAbilityTranslations(ctx context.Context, imp *importer) error {
+	stats := imp.summary.table("ability_translations")
+
+	t, err := readTranslations(imp.dir, translationFiles{
+		names:         "ability_names.csv",
+		namesID:       "ability_id",
+		flavor:        "ability_flavor_text.csv",
+		flavorID:      "ability_id",
+		flavorVersion: "version_group_id",
+	})
+	if err != nil {
+		return err
+	}
+
+	var rows []model.AbilityTranslation
+	for _, key := range t.sorted() {
+		if !imp.abilities[key.id] {
+			stats.Skipped++
+			continue
+		}
+		entry := t[key]
+		rows = append(rows, model.AbilityTranslation{
+			AbilityID:  key.id,
+			Language:   key.language,
+			Name:       entry.name,
+			FlavorText: entry.flavorText,
+		})
+	}
+
+	return upsert(ctx, imp.logger, table[model.AbilityTranslation]{
+		name:     "ability_translations",
+		conflict: []string{"ability_id", "language"},
+		update:   []string{"name", "flavor_text"},
+		key:      func(a *model.AbilityTranslation) string { return fmt.Sprintf("%d/%s", a.AbilityID, a.Language) },
+		prune:    true,
+	}, rows, stats)
+}
@@ -0,0 +1,115 @@
+package importer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"pokedex_backend_go/pkg/database"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm/clause"
+)
+
+const batchSize = 500
+
+// table describes how rows of a model are matched against the database.
+type table[T any] struct {
+	name string
+	// conflict are the columns identifying a row, used for ON CONFLICT.
+	conflict []string
+	// update are the columns overwritten when a row already exists.
+	update []string
+	key    func(row *T) string
+	// prune deletes rows that are no longer in the dump. It is only used for
+	// link tables such as pokemon_types, where a stale row would be wrong
+	// data rather than just an old entry.
+	prune bool
+}
+
+// upsert compares the rows read from the dump with the ones in the database
+// and only writes the rows that are new or changed, so rerunning the import
+// on the same dump writes nothing.
+func upsert[T any](ctx context.Context, logger *zap.Logger, t table[T], rows []T, stats *TableStats) error {
+	orm := database.Orm(ctx)
+
+	var existing []T
+	if err := orm.WithContext(ctx).Find(&existing).Error; err != nil {
+		logger.Error("Failed to load existing rows", zap.String("table", t.name), zap.Error(err))
+		return err
+	}
+
+	current := make(map[string][]byte, len(existing))
+	for i := range existing {
+		encoded, err := json.Marshal(&existing[i])
+		if err != nil {
+			return err
+		}
+		current[t.key(&existing[i])] = encoded
+	}
+
+	var changed []T
+	seen := make(map[string]bool, len(rows))
+	for i := range rows {
+		key := t.key(&rows[i])
+		seen[key] = true
+
+		encoded, err := json.Marshal(&rows[i])
+		if err != nil {
+			return err
+		}
+
+		previous, exists := current[key]
+		switch {
+		case !exists:
+			stats.Inserted++
+		case !bytes.Equal(previous, encoded):
+			stats.Updated++
+		default:
+			stats.Skipped++
+			continue
+		}
+
+		changed = append(changed, rows[i])
+	}
+
+	if len(changed) > 0 {
+		columns := make([]clause.Column, 0, len(t.conflict))
+		for _, name := range t.conflict {
+			columns = append(columns, clause.Column{Name: name})
+		}
+
+		result := orm.WithContext(ctx).
+			Omit(clause.Associations).
+			Clauses(clause.OnConflict{Columns: columns, DoUpdates: clause.AssignmentColumns(t.update)}).
+			CreateInBatches(changed, batchSize)
+		if result.Error != nil {
+			logger.Error("Failed to upsert rows", zap.String("table", t.name), zap.Error(result.Error))
+			return result.Error
+		}
+	}
+
+	if t.prune {
+		for i := range existing {
+			if seen[t.key(&existing[i])] {
+				continue
+			}
+
+			if err := orm.WithContext(ctx).Delete(&existing[i]).Error; err != nil {
+				logger.Error("Failed to delete stale row", zap.String("table", t.name), zap.Error(err))
+				return err
+			}
+			stats.Deleted++
+		}
+	}
+
+	logger.Info("Table imported",
+		zap.String("table", t.name),
+		zap.Int("inserted", stats.Inserted),
+		zap.Int("updated", stats.Updated),
+		zap.Int("skipped", stats.Skipped),
+		zap.Int("deleted", stats.Deleted),
+	)
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package logger
+
+import (
+	"context"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type contextKey struct{}
+
+// requestLogger is shared by everything that handles one request, so the
+// fields added with With, such as the user, also reach the access log
+// written once the request is done.
+type requestLogger struct {
+	logger atomic.Pointer[zap.Logger]
+}
+
+// NewContext returns a copy of ctx that carries l as the logger of the
+// request.
+func NewContext(ctx context.Context, l *zap.Logger) context.Context {
+	holder := &requestLogger{}
+	holder.logger.Store(l)
+	return context.WithValue(ctx, contextKey{}, holder)
+}
+
+// With adds fields to the logger of the request in ctx from then on, for
+// every caller that shares the request. Outside a request it does nothing.
+func With(ctx context.Context, fields ...zap.Field) {
+	if holder, ok := ctx.Value(contextKey{}).(*requestLogger); ok {
+		holder.logger.Store(holder.logger.Load().With(fields...))
+	}
+}
+
+// FromContext returns the logger of the request in ctx, with the trace_id
+// and span_id of the current span, so that a log line leads to its request
+// and its trace. Outside a request it falls back to zap.L().
+func FromContext(ctx context.Context) *zap.Logger {
+	l := zap.L()
+	if holder, ok := ctx.Value(contextKey{}).(*requestLogger); ok {
+		l = holder.logger.Load()
+	}
+
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.IsValid() {
+		return l
+	}
+
+	return l.With(
+		zap.String("trace_id", spanContext.TraceID().String()),
+		zap.String("span_id", spanContext.SpanID().String()),
+	)
+}
@@ -28,6 +28,18 @@ func initLoggerConfig() {
 	cfg.Level = level
 }
 
+// SetLevel changes the level of every logger built by NewLogger, including
+// the ones created before the configuration was loaded.
+func SetLevel(level string) error {
+	parsed, err := zap.ParseAtomicLevel(level)
+	if err != nil {
+		return fmt.Errorf("failed to parse log level: %v", err)
+	}
+
+	cfg.Level.SetLevel(parsed.Level())
+	return nil
+}
+
 func NewLogger(name string, opts ...zap.Option) *zap.Logger {
 	logger, err := cfg.Build(opts...)
 	if err != nil {
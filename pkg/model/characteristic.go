@@ -0,0 +1,9 @@
+package model
+
+// Characteristic is the in-game description hinting at which stat has the
+// highest IV and the remainder of that IV divided by 5.
+type Characteristic struct {
+	Stat        string `gorm:"primaryKey" json:"stat"`
+	IVRemainder int    `gorm:"column:iv_remainder;primaryKey;autoIncrement:false" json:"iv_remainder"`
+	Description string `gorm:"unique;not null" json:"description"`
+}
@@ -0,0 +1,11 @@
+package model
+
+type EggGroup struct {
+	ID   int    `gorm:"primaryKey;autoIncrement:false" json:"id"`
+	Name string `gorm:"unique;not null" json:"name"`
+}
+
+type PokemonEggGroup struct {
+	SpeciesID  int `gorm:"primaryKey;autoIncrement:false" json:"species_id"`
+	EggGroupID int `gorm:"primaryKey;autoIncrement:false" json:"egg_group_id"`
+}
@@ -0,0 +1,57 @@
+package model
+
+const (
+	GenderFemale = "female"
+	GenderMale   = "male"
+)
+
+type EvolutionChain struct {
+	ID                int   `gorm:"primaryKey;autoIncrement:false" json:"id"`
+	BabyTriggerItemID *int  `json:"baby_trigger_item_id"`
+	BabyTriggerItem   *Item `gorm:"foreignKey:BabyTriggerItemID" json:"-"`
+}
+
+type EvolutionTrigger struct {
+	ID   int    `gorm:"primaryKey;autoIncrement:false" json:"id"`
+	Name string `gorm:"unique;not null" json:"name"`
+}
+
+type Location struct {
+	ID       int    `gorm:"primaryKey;autoIncrement:false" json:"id"`
+	Name     string `gorm:"unique;not null" json:"name"`
+	RegionID *int   `json:"region_id"`
+}
+
+// PokemonEvolution is one way of evolving into EvolvedSpeciesID from the
+// species it evolves from. Every condition that is set must hold at once.
+type PokemonEvolution struct {
+	ID                    int               `gorm:"primaryKey;autoIncrement:false" json:"id"`
+	EvolvedSpeciesID      int               `gorm:"not null" json:"evolved_species_id"`
+	EvolutionTriggerID    int               `gorm:"not null" json:"evolution_trigger_id"`
+	Trigger               *EvolutionTrigger `gorm:"foreignKey:EvolutionTriggerID" json:"-"`
+	TriggerItemID         *int              `json:"trigger_item_id"`
+	TriggerItem           *Item             `gorm:"foreignKey:TriggerItemID" json:"-"`
+	MinimumLevel          *int              `json:"minimum_level"`
+	Gender                *string           `json:"gender"`
+	LocationID            *int              `json:"location_id"`
+	Location              *Location         `gorm:"foreignKey:LocationID" json:"-"`
+	HeldItemID            *int              `json:"held_item_id"`
+	HeldItem              *Item             `gorm:"foreignKey:HeldItemID" json:"-"`
+	TimeOfDay             *string           `json:"time_of_day"`
+	KnownMoveID           *int              `json:"known_move_id"`
+	KnownMove             *Move             `gorm:"foreignKey:KnownMoveID" json:"-"`
+	KnownMoveTypeID       *int              `json:"known_move_type_id"`
+	KnownMoveType         *Type             `gorm:"foreignKey:KnownMoveTypeID" json:"-"`
+	MinimumHappiness      *int              `json:"minimum_happiness"`
+	MinimumBeauty         *int              `json:"minimum_beauty"`
+	MinimumAffection      *int              `json:"minimum_affection"`
+	RelativePhysicalStats *int              `json:"relative_physical_stats"`
+	PartySpeciesID        *int              `json:"party_species_id"`
+	PartySpecies          *PokemonSpecies   `gorm:"foreignKey:PartySpeciesID" json:"-"`
+	PartyTypeID           *int              `json:"party_type_id"`
+	PartyType             *Type             `gorm:"foreignKey:PartyTypeID" json:"-"`
+	TradeSpeciesID        *int              `json:"trade_species_id"`
+	TradeSpecies          *PokemonSpecies   `gorm:"foreignKey:TradeSpeciesID" json:"-"`
+	NeedsOverworldRain    bool              `json:"needs_overworld_rain"`
+	TurnUpsideDown        bool              `json:"turn_upside_down"`
+}
@@ -0,0 +1,12 @@
+package model
+
+type Item struct {
+	ID          int    `gorm:"primaryKey;autoIncrement:false" json:"id"`
+	Name        string `gorm:"unique;not null" json:"name"`
+	Cost        int    `json:"cost"`
+	FlingPower  *int   `json:"fling_power"`
+	Category    string `json:"category"`
+	Holdable    bool   `json:"holdable"`
+	ShortEffect string `json:"short_effect"`
+	Effect      string `json:"effect"`
+}
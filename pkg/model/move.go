@@ -0,0 +1,41 @@
+package model
+
+const (
+	DamageClassPhysical = "physical"
+	DamageClassSpecial  = "special"
+	DamageClassStatus   = "status"
+)
+
+type Move struct {
+	ID           int    `gorm:"primaryKey;autoIncrement:false" json:"id"`
+	Name         string `gorm:"unique;not null" json:"name"`
+	Generation   int    `gorm:"not null" json:"generation"`
+	TypeID       int    `gorm:"not null" json:"type_id"`
+	Power        *int   `json:"power"`
+	PP           *int   `gorm:"column:pp" json:"pp"`
+	Accuracy     *int   `json:"accuracy"`
+	Priority     int    `json:"priority"`
+	DamageClass  string `gorm:"not null" json:"damage_class"`
+	EffectChance *int   `json:"effect_chance"`
+	ShortEffect  string `json:"short_effect"`
+	Effect       string `json:"effect"`
+	Type         *Type  `gorm:"foreignKey:TypeID" json:"-"`
+}
+
+type MoveLearnMethod struct {
+	ID   int    `gorm:"primaryKey;autoIncrement:false" json:"id"`
+	Name string `gorm:"unique;not null" json:"name"`
+}
+
+// PokemonMove is a move a variety learns in a version group. Level is 0 for
+// every learn method but level-up.
+type PokemonMove struct {
+	PokemonID      int              `gorm:"primaryKey;autoIncrement:false" json:"pokemon_id"`
+	VersionGroupID int              `gorm:"primaryKey;autoIncrement:false" json:"version_group_id"`
+	MoveID         int              `gorm:"primaryKey;autoIncrement:false" json:"move_id"`
+	LearnMethodID  int              `gorm:"primaryKey;autoIncrement:false" json:"learn_method_id"`
+	Level          int              `gorm:"primaryKey;autoIncrement:false" json:"level"`
+	SortOrder      *int             `json:"order"`
+	Move           *Move            `gorm:"foreignKey:MoveID" json:"-"`
+	LearnMethod    *MoveLearnMethod `gorm:"foreignKey:LearnMethodID" json:"-"`
+}
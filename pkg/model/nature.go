@@ -0,0 +1,10 @@
+package model
+
+// Nature raises one stat by 10% and lowers another by 10%. Neutral natures
+// have neither.
+type Nature struct {
+	ID            int     `gorm:"primaryKey;autoIncrement:false" json:"id"`
+	Name          string  `gorm:"unique;not null" json:"name"`
+	IncreasedStat *string `json:"increased_stat"`
+	DecreasedStat *string `json:"decreased_stat"`
+}
@@ -0,0 +1,19 @@
+package model
+
+// Pokedex is a regional dex such as kanto or paldea, or the national one.
+type Pokedex struct {
+	ID           int    `gorm:"primaryKey;autoIncrement:false" json:"id"`
+	Name         string `gorm:"unique;not null" json:"name"`
+	RegionID     *int   `json:"region_id"`
+	IsMainSeries bool   `gorm:"not null" json:"is_main_series"`
+}
+
+func (Pokedex) TableName() string {
+	return "pokedexes"
+}
+
+type PokedexNumber struct {
+	PokedexID int `gorm:"primaryKey;autoIncrement:false" json:"pokedex_id"`
+	SpeciesID int `gorm:"primaryKey;autoIncrement:false" json:"species_id"`
+	Number    int `gorm:"not null" json:"number"`
+}
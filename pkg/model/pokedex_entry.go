@@ -0,0 +1,30 @@
+package model
+
+import "time"
+
+const (
+	PokedexStatusSeen   = "seen"
+	PokedexStatusCaught = "caught"
+	PokedexStatusShiny  = "shiny"
+)
+
+// PokedexEntry is a mark on a user's own Pokédex: a species, or one of its
+// forms when FormID is set, seen, caught or caught shiny.
+type PokedexEntry struct {
+	ID        string          `gorm:"type:uuid;default:gen_random_uuid();primaryKey" json:"id"`
+	UserID    string          `gorm:"type:uuid;not null" json:"-"`
+	SpeciesID int             `gorm:"not null" json:"species_id"`
+	FormID    *int            `json:"form_id"`
+	Status    string          `gorm:"not null" json:"status"`
+	VersionID *int            `json:"-"`
+	MarkedAt  time.Time       `gorm:"not null" json:"marked_at"`
+	Species   *PokemonSpecies `gorm:"foreignKey:SpeciesID" json:"-"`
+	Form      *PokemonForm    `gorm:"foreignKey:FormID" json:"-"`
+	Version   *Version        `gorm:"foreignKey:VersionID" json:"-"`
+	CreatedAt time.Time       `json:"-"`
+	UpdatedAt time.Time       `json:"-"`
+}
+
+func (PokedexEntry) TableName() string {
+	return "user_pokedex_entries"
+}
@@ -0,0 +1,118 @@
+package model
+
+import "time"
+
+type Type struct {
+	ID         int    `gorm:"primaryKey;autoIncrement:false" json:"id"`
+	Name       string `gorm:"unique;not null" json:"name"`
+	Generation int    `gorm:"not null" json:"generation"`
+}
+
+type Ability struct {
+	ID           int    `gorm:"primaryKey;autoIncrement:false" json:"id"`
+	Name         string `gorm:"unique;not null" json:"name"`
+	Generation   int    `gorm:"not null" json:"generation"`
+	IsMainSeries bool   `gorm:"not null" json:"is_main_series"`
+	ShortEffect  string `json:"short_effect"`
+	Effect       string `json:"effect"`
+}
+
+type PokemonSpecies struct {
+	ID                   int       `gorm:"primaryKey;autoIncrement:false" json:"id"`
+	Name                 string    `gorm:"unique;not null" json:"name"`
+	Generation           int       `gorm:"not null" json:"generation"`
+	EvolvesFromSpeciesID *int      `json:"evolves_from_species_id"`
+	EvolutionChainID     *int      `json:"evolution_chain_id"`
+	GenderRate           int       `json:"gender_rate"`
+	CaptureRate          int       `json:"capture_rate"`
+	BaseHappiness        *int      `json:"base_happiness"`
+	HatchCounter         *int      `json:"hatch_counter"`
+	IsBaby               bool      `json:"is_baby"`
+	IsLegendary          bool      `json:"is_legendary"`
+	IsMythical           bool      `json:"is_mythical"`
+	SortOrder            int       `json:"order"`
+	Varieties            []Pokemon `gorm:"foreignKey:SpeciesID" json:"varieties,omitempty"`
+	CreatedAt            time.Time `json:"-"`
+	UpdatedAt            time.Time `json:"-"`
+}
+
+func (PokemonSpecies) TableName() string {
+	return "pokemon_species"
+}
+
+// Pokemon is a variety of a species, e.g. charizard and charizard-mega-x are
+// both varieties of the charizard species.
+type Pokemon struct {
+	ID             int               `gorm:"primaryKey;autoIncrement:false" json:"id"`
+	SpeciesID      int               `gorm:"not null" json:"species_id"`
+	Species        *PokemonSpecies   `gorm:"foreignKey:SpeciesID" json:"-"`
+	Name           string            `gorm:"unique;not null" json:"name"`
+	IsDefault      bool              `json:"is_default"`
+	Height         int               `json:"height"`
+	Weight         int               `json:"weight"`
+	BaseExperience *int              `json:"base_experience"`
+	SortOrder      int               `json:"order"`
+	Types          []PokemonType     `gorm:"foreignKey:PokemonID" json:"types,omitempty"`
+	Abilities      []PokemonAbility  `gorm:"foreignKey:PokemonID" json:"abilities,omitempty"`
+	Stats          *PokemonBaseStats `gorm:"foreignKey:PokemonID" json:"stats,omitempty"`
+	Forms          []PokemonForm     `gorm:"foreignKey:PokemonID" json:"forms,omitempty"`
+}
+
+func (Pokemon) TableName() string {
+	return "pokemon"
+}
+
+type PokemonForm struct {
+	ID           int    `gorm:"primaryKey;autoIncrement:false" json:"id"`
+	PokemonID    int    `gorm:"not null" json:"pokemon_id"`
+	Name         string `gorm:"unique;not null" json:"name"`
+	FormName     string `json:"form_name"`
+	IsDefault    bool   `json:"is_default"`
+	IsBattleOnly bool   `json:"is_battle_only"`
+	IsMega       bool   `json:"is_mega"`
+	FormOrder    int    `json:"form_order"`
+}
+
+type PokemonType struct {
+	PokemonID int  `gorm:"primaryKey;autoIncrement:false" json:"-"`
+	Slot      int  `gorm:"primaryKey;autoIncrement:false" json:"slot"`
+	TypeID    int  `gorm:"not null" json:"-"`
+	Type      Type `json:"type"`
+}
+
+type PokemonAbility struct {
+	PokemonID int     `gorm:"primaryKey;autoIncrement:false" json:"-"`
+	Slot      int     `gorm:"primaryKey;autoIncrement:false" json:"slot"`
+	AbilityID int     `gorm:"not null" json:"-"`
+	IsHidden  bool    `json:"is_hidden"`
+	Ability   Ability `json:"ability"`
+}
+
+type PokemonBaseStats struct {
+	PokemonID      int `gorm:"primaryKey;autoIncrement:false" json:"-"`
+	HP             int `gorm:"column:hp" json:"hp"`
+	Attack         int `json:"attack"`
+	Defense        int `json:"defense"`
+	SpecialAttack  int `json:"special_attack"`
+	SpecialDefense int `json:"special_defense"`
+	Speed          int `json:"speed"`
+}
+
+func (PokemonBaseStats) TableName() string {
+	return "pokemon_base_stats"
+}
+
+func (s PokemonBaseStats) Total() int {
+	return s.HP + s.Attack + s.Defense + s.SpecialAttack + s.SpecialDefense + s.Speed
+}
+
+func (s PokemonBaseStats) Spread() StatSpread {
+	return StatSpread{
+		HP:             s.HP,
+		Attack:         s.Attack,
+		Defense:        s.Defense,
+		SpecialAttack:  s.SpecialAttack,
+		SpecialDefense: s.SpecialDefense,
+		Speed:          s.Speed,
+	}
+}
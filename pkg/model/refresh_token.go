@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+type RefreshToken struct {
+	ID         string     `gorm:"type:uuid;default:gen_random_uuid();primaryKey" json:"id"`
+	UserID     string     `gorm:"type:uuid;not null" json:"user_id"`
+	FamilyID   string     `gorm:"type:uuid;not null" json:"family_id"`
+	TokenHash  string     `gorm:"unique;not null" json:"-"`
+	ExpiresAt  time.Time  `gorm:"not null" json:"expires_at"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+	ReplacedBy *string    `gorm:"type:uuid" json:"replaced_by"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
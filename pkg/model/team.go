@@ -0,0 +1,78 @@
+package model
+
+import "time"
+
+// StatNames lists the stats in the order the games show them, named like the
+// columns and JSON fields of StatSpread.
+var StatNames = []string{"hp", "attack", "defense", "special_attack", "special_defense", "speed"}
+
+// StatSpread holds one value per stat, used for EVs, IVs and computed stats.
+type StatSpread struct {
+	HP             int `gorm:"column:hp" json:"hp"`
+	Attack         int `json:"attack"`
+	Defense        int `json:"defense"`
+	SpecialAttack  int `json:"special_attack"`
+	SpecialDefense int `json:"special_defense"`
+	Speed          int `json:"speed"`
+}
+
+func (s StatSpread) Total() int {
+	return s.HP + s.Attack + s.Defense + s.SpecialAttack + s.SpecialDefense + s.Speed
+}
+
+// Field returns the value of the stat with the given name, one of
+// StatNames, or nil for an unknown name.
+func (s *StatSpread) Field(name string) *int {
+	switch name {
+	case "hp":
+		return &s.HP
+	case "attack":
+		return &s.Attack
+	case "defense":
+		return &s.Defense
+	case "special_attack":
+		return &s.SpecialAttack
+	case "special_defense":
+		return &s.SpecialDefense
+	case "speed":
+		return &s.Speed
+	}
+
+	return nil
+}
+
+type Team struct {
+	ID        string       `gorm:"type:uuid;default:gen_random_uuid();primaryKey" json:"id"`
+	UserID    string       `gorm:"type:uuid;not null" json:"-"`
+	Name      string       `gorm:"not null" json:"name"`
+	Format    string       `json:"format"`
+	Members   []TeamMember `gorm:"foreignKey:TeamID" json:"members"`
+	CreatedAt time.Time    `json:"created_at"`
+	UpdatedAt time.Time    `json:"updated_at"`
+}
+
+type TeamMember struct {
+	ID        string           `gorm:"type:uuid;default:gen_random_uuid();primaryKey" json:"-"`
+	TeamID    string           `gorm:"type:uuid;not null" json:"-"`
+	Slot      int              `gorm:"not null" json:"slot"`
+	PokemonID int              `gorm:"not null" json:"pokemon_id"`
+	Pokemon   *Pokemon         `json:"-"`
+	Nickname  string           `json:"nickname"`
+	Level     int              `gorm:"not null" json:"level"`
+	AbilityID int              `gorm:"not null" json:"ability_id"`
+	Ability   *Ability         `json:"-"`
+	Item      string           `json:"item"`
+	NatureID  *int             `json:"nature_id"`
+	Nature    *Nature          `json:"-"`
+	Shiny     bool             `json:"shiny"`
+	EVs       StatSpread       `gorm:"embedded;embeddedPrefix:ev_" json:"evs"`
+	IVs       StatSpread       `gorm:"embedded;embeddedPrefix:iv_" json:"ivs"`
+	Moves     []TeamMemberMove `gorm:"foreignKey:MemberID" json:"moves"`
+}
+
+type TeamMemberMove struct {
+	MemberID string `gorm:"type:uuid;primaryKey" json:"-"`
+	Slot     int    `gorm:"primaryKey;autoIncrement:false" json:"slot"`
+	MoveID   int    `gorm:"not null" json:"move_id"`
+	Move     *Move  `json:"-"`
+}
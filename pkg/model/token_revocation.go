@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+type RevokedToken struct {
+	JTI       string    `gorm:"column:jti;primaryKey" json:"jti"`
+	UserID    string    `gorm:"type:uuid;not null" json:"user_id"`
+	ExpiresAt time.Time `gorm:"not null" json:"expires_at"`
+	RevokedAt time.Time `gorm:"autoCreateTime" json:"revoked_at"`
+}
+
+type UserTokenRevocation struct {
+	UserID        string    `gorm:"type:uuid;primaryKey" json:"user_id"`
+	RevokedBefore time.Time `gorm:"not null" json:"revoked_before"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
@@ -0,0 +1,25 @@
+package model
+
+// Translations are keyed by a BCP 47 language code, see i18n.Code.
+
+type SpeciesTranslation struct {
+	SpeciesID  int    `gorm:"primaryKey;autoIncrement:false" json:"species_id"`
+	Language   string `gorm:"primaryKey" json:"language"`
+	Name       string `json:"name"`
+	Genus      string `json:"genus"`
+	FlavorText string `json:"flavor_text"`
+}
+
+type MoveTranslation struct {
+	MoveID     int    `gorm:"primaryKey;autoIncrement:false" json:"move_id"`
+	Language   string `gorm:"primaryKey" json:"language"`
+	Name       string `json:"name"`
+	FlavorText string `json:"flavor_text"`
+}
+
+type AbilityTranslation struct {
+	AbilityID  int    `gorm:"primaryKey;autoIncrement:false" json:"ability_id"`
+	Language   string `gorm:"primaryKey" json:"language"`
+	Name       string `json:"name"`
+	FlavorText string `json:"flavor_text"`
+}
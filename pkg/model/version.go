@@ -0,0 +1,14 @@
+package model
+
+type VersionGroup struct {
+	ID         int    `gorm:"primaryKey;autoIncrement:false" json:"id"`
+	Name       string `gorm:"unique;not null" json:"name"`
+	Generation int    `gorm:"not null" json:"generation"`
+	SortOrder  int    `json:"order"`
+}
+
+type Version struct {
+	ID             int    `gorm:"primaryKey;autoIncrement:false" json:"id"`
+	Name           string `gorm:"unique;not null" json:"name"`
+	VersionGroupID int    `gorm:"not null" json:"version_group_id"`
+}
@@ -0,0 +1,121 @@
+// Package problem reports errors as RFC 7807 problem details
+// (application/problem+json).
+//
+// Domain errors are sentinels created with New, which carry the status and
+// code they are reported with, so handlers pass any error to Write instead
+// of switching on it:
+//
+//	var ErrUserNotFound = problem.New(http.StatusNotFound, "user_not_found", "User not found")
+//
+// Wrapping a sentinel in an i18n.Message adds the detail of one occurrence,
+// such as the name of an unknown move.
+package problem
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"pokedex_backend_go/pkg/dto"
+	"pokedex_backend_go/pkg/i18n"
+	"pokedex_backend_go/pkg/validation"
+
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+const ContentType = "application/problem+json"
+
+// TypePrefix is prepended to the code to form the type URI of a problem.
+const TypePrefix = "/problems/"
+
+var (
+	ErrInternal    = New(http.StatusInternalServerError, "internal_error", "Internal server error")
+	ErrInvalidJSON = New(http.StatusBadRequest, "invalid_json", "Invalid JSON format")
+	ErrValidation  = New(http.StatusBadRequest, "validation_failed", "Invalid request")
+)
+
+// Error is a kind of problem. Title is its English summary and a key of the
+// i18n catalog.
+type Error struct {
+	Status int
+	Code   string
+	Title  string
+}
+
+func New(status int, code, title string) *Error {
+	return &Error{Status: status, Code: code, Title: title}
+}
+
+func (e *Error) Error() string {
+	return e.Title
+}
+
+// Field attributes err to a field of the request, e.g. the "mark[2]" entry
+// of a batch, reporting it in the errors of the problem.
+func Field(field string, err error) error {
+	return &fieldError{field: field, err: err}
+}
+
+type fieldError struct {
+	field string
+	err   error
+}
+
+func (e *fieldError) Error() string {
+	return e.field + ": " + e.err.Error()
+}
+
+func (e *fieldError) Unwrap() error {
+	return e.err
+}
+
+// Internal reports whether err is not a known problem and is reported as
+// ErrInternal, so callers know to log it.
+func Internal(err error) bool {
+	var fields validation.Errors
+	var kind *Error
+	return !errors.As(err, &fields) && (!errors.As(err, &kind) || kind.Status >= http.StatusInternalServerError)
+}
+
+// Write replies with the problem err stands for. Validation errors are
+// reported as ErrValidation with every field error, and unknown errors as
+// ErrInternal without leaking their text.
+func Write(w http.ResponseWriter, r *http.Request, err error) {
+	ctx := r.Context()
+
+	kind := ErrInternal
+	var fields validation.Errors
+	if errors.As(err, &fields) {
+		kind = ErrValidation
+	} else {
+		errors.As(err, &kind)
+	}
+
+	response := &dto.ProblemResponse{
+		Type:     TypePrefix + kind.Code,
+		Title:    i18n.Sprintf(ctx, kind.Title),
+		Status:   kind.Status,
+		Instance: middleware.GetReqID(ctx),
+		Code:     kind.Code,
+		Errors:   fields.Localize(i18n.Printer(ctx)),
+	}
+
+	var message *i18n.Message
+	if kind != ErrInternal && errors.As(err, &message) {
+		response.Detail = i18n.Sprintf(ctx, message.Key, message.Args...)
+	}
+
+	var field *fieldError
+	if errors.As(err, &field) {
+		response.Errors = append(response.Errors, validation.FieldError{
+			Field:   field.field,
+			Code:    kind.Code,
+			Message: i18n.Pick(response.Detail, response.Title),
+		})
+	}
+
+	w.Header().Set("Content-Type", ContentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(response.Status)
+	_ = json.NewEncoder(w).Encode(response)
+}
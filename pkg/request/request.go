@@ -0,0 +1,134 @@
+// Package request decodes and validates JSON request bodies.
+//
+// Payloads declare their rules in `validate` tags (see validation.Struct), so
+// a handler only decodes and writes the error it gets back:
+//
+//	var req LoginPayload
+//	if err := request.Decode(w, r, &req); err != nil {
+//		problem.Write(w, r, err)
+//		return
+//	}
+package request
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"pokedex_backend_go/pkg/i18n"
+	"pokedex_backend_go/pkg/problem"
+	"pokedex_backend_go/pkg/validation"
+)
+
+// MaxBodySize bounds a JSON body. The largest payloads are full teams and
+// bulk Pokédex updates, which stay well below it.
+const MaxBodySize = 1 << 20
+
+var ErrBodyTooLarge = problem.New(http.StatusRequestEntityTooLarge, "body_too_large", "Request body is too large")
+
+// Decode reads the JSON body of r into dst, a pointer to a struct, and checks
+// its validate tags. Bodies over MaxBodySize, malformed JSON and trailing data
+// are reported as problems; unknown fields, values of the wrong type and
+// failed rules are collected in validation.Errors.
+func Decode(w http.ResponseWriter, r *http.Request, dst any) error {
+	body := http.MaxBytesReader(w, r.Body, MaxBodySize)
+	defer body.Close()
+
+	decoder := json.NewDecoder(body)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(dst); err != nil {
+		return decodeError(err)
+	}
+	if decoder.More() {
+		return i18n.Errorf(problem.ErrInvalidJSON, "request body must contain a single JSON value")
+	}
+
+	return validation.Struct(dst).Err()
+}
+
+// DecodeOptional is Decode for endpoints whose body may be left out, in which
+// case dst keeps its zero value and is still validated.
+func DecodeOptional(w http.ResponseWriter, r *http.Request, dst any) error {
+	if r.ContentLength == 0 {
+		return validation.Struct(dst).Err()
+	}
+
+	return Decode(w, r, dst)
+}
+
+func decodeError(err error) error {
+	var tooLarge *http.MaxBytesError
+	var syntax *json.SyntaxError
+	var wrongType *json.UnmarshalTypeError
+
+	switch {
+	case errors.As(err, &tooLarge):
+		return i18n.Errorf(ErrBodyTooLarge, "request body must be at most %d bytes", tooLarge.Limit)
+	case errors.Is(err, io.EOF):
+		return i18n.Errorf(problem.ErrInvalidJSON, "request body is empty")
+	case errors.Is(err, io.ErrUnexpectedEOF):
+		return i18n.Errorf(problem.ErrInvalidJSON, "request body ends unexpectedly")
+	case errors.As(err, &syntax):
+		return i18n.Errorf(problem.ErrInvalidJSON, "malformed JSON at byte %d", syntax.Offset)
+	case errors.As(err, &wrongType):
+		if wrongType.Field == "" {
+			return i18n.Errorf(problem.ErrInvalidJSON, "request body must be a JSON object")
+		}
+		field, name := fieldPath(wrongType.Field)
+		var errs validation.Errors
+		errs.Add(field, "invalid_type", "%s must be of type %s", name, jsonType(wrongType.Type.Kind()))
+		return errs
+	}
+
+	// encoding/json has no error type for unknown fields.
+	if field, ok := strings.CutPrefix(err.Error(), "json: unknown field "); ok {
+		var errs validation.Errors
+		errs.Add(strings.Trim(field, `"`), "unknown_field", "unknown field")
+		return errs
+	}
+
+	return problem.ErrInvalidJSON
+}
+
+// fieldPath turns the dotted path of encoding/json, e.g. "members.0.level",
+// into the form of validation errors, "members[0].level", and returns it
+// with the key of the field.
+func fieldPath(dotted string) (path, name string) {
+	var b strings.Builder
+	for _, part := range strings.Split(dotted, ".") {
+		if _, err := strconv.Atoi(part); err == nil {
+			b.WriteString("[" + part + "]")
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteByte('.')
+		}
+		b.WriteString(part)
+		name = part
+	}
+
+	return b.String(), name
+}
+
+// jsonType names a Go kind the way a client writing JSON would.
+func jsonType(kind reflect.Kind) string {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	case reflect.Struct, reflect.Map:
+		return "object"
+	}
+
+	return kind.String()
+}
@@ -0,0 +1,327 @@
+// Package search parses the filter language of the pokemon search. A query
+// is a list of terms separated by spaces, all of which must match:
+//
+//	type:dragon type:ground speed>=100 gen<=4 learns:earthquake legendary:false
+//
+// String fields take comma separated alternatives (type:fire,water) and
+// accept = (or :) and !=; numeric fields accept every comparison operator.
+// Values with spaces are quoted: ability:"sand rush".
+// Parsing only checks the syntax and the fields; whether a type or a move
+// exists is up to the caller.
+package search
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"pokedex_backend_go/pkg/identifier"
+)
+
+// MaxTerms bounds the number of terms of a query.
+const MaxTerms = 20
+
+const (
+	Equal          = "="
+	NotEqual       = "!="
+	Greater        = ">"
+	GreaterOrEqual = ">="
+	Less           = "<"
+	LessOrEqual    = "<="
+)
+
+// operators are tried in order, so two-character operators come first.
+var operators = []string{GreaterOrEqual, LessOrEqual, NotEqual, Greater, Less, Equal, ":"}
+
+type kind int
+
+const (
+	kindString kind = iota
+	kindInt
+	kindBool
+)
+
+// Fields that can be filtered on, by canonical name.
+const (
+	FieldType           = "type"
+	FieldAbility        = "ability"
+	FieldLearns         = "learns"
+	FieldEggGroup       = "egg_group"
+	FieldGeneration     = "generation"
+	FieldHP             = "hp"
+	FieldAttack         = "attack"
+	FieldDefense        = "defense"
+	FieldSpecialAttack  = "special_attack"
+	FieldSpecialDefense = "special_defense"
+	FieldSpeed          = "speed"
+	FieldTotal          = "bst"
+	FieldLegendary      = "legendary"
+	FieldMythical       = "mythical"
+	FieldBaby           = "baby"
+	// FieldForms includes the alternate varieties (megas, regional forms,
+	// ...) in the results when true.
+	FieldForms = "forms"
+	// FieldID and FieldName can only be sorted on.
+	FieldID   = "id"
+	FieldName = "name"
+)
+
+var kinds = map[string]kind{
+	FieldType:           kindString,
+	FieldAbility:        kindString,
+	FieldLearns:         kindString,
+	FieldEggGroup:       kindString,
+	FieldGeneration:     kindInt,
+	FieldHP:             kindInt,
+	FieldAttack:         kindInt,
+	FieldDefense:        kindInt,
+	FieldSpecialAttack:  kindInt,
+	FieldSpecialDefense: kindInt,
+	FieldSpeed:          kindInt,
+	FieldTotal:          kindInt,
+	FieldLegendary:      kindBool,
+	FieldMythical:       kindBool,
+	FieldBaby:           kindBool,
+	FieldForms:          kindBool,
+}
+
+var sortable = map[string]bool{
+	FieldID:             true,
+	FieldName:           true,
+	FieldGeneration:     true,
+	FieldHP:             true,
+	FieldAttack:         true,
+	FieldDefense:        true,
+	FieldSpecialAttack:  true,
+	FieldSpecialDefense: true,
+	FieldSpeed:          true,
+	FieldTotal:          true,
+}
+
+var aliases = map[string]string{
+	"move":   FieldLearns,
+	"egg":    FieldEggGroup,
+	"gen":    FieldGeneration,
+	"atk":    FieldAttack,
+	"def":    FieldDefense,
+	"spa":    FieldSpecialAttack,
+	"spatk":  FieldSpecialAttack,
+	"spd":    FieldSpecialDefense,
+	"spdef":  FieldSpecialDefense,
+	"spe":    FieldSpeed,
+	"total":  FieldTotal,
+	"number": FieldID,
+}
+
+// Filter is a single term. Values holds the alternatives of string fields,
+// Number and Bool the value of the other kinds.
+type Filter struct {
+	Term     string
+	Field    string
+	Operator string
+	Values   []string
+	Number   int
+	Bool     bool
+}
+
+// Sort orders the results by Field.
+type Sort struct {
+	Field      string
+	Descending bool
+}
+
+type Query struct {
+	Filters []Filter
+}
+
+// Has reports whether the query filters on field.
+func (q *Query) Has(field string) bool {
+	for _, f := range q.Filters {
+		if f.Field == field {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Error is a problem with one term of a query. Format and Args are what
+// Message was rendered from, so it can be translated.
+type Error struct {
+	Term    string
+	Message string
+	Format  string
+	Args    []any
+}
+
+func newError(term, format string, args ...any) Error {
+	return Error{Term: term, Message: fmt.Sprintf(format, args...), Format: format, Args: args}
+}
+
+// Errors lists every problem of a query.
+type Errors []Error
+
+func (e Errors) Error() string {
+	parts := make([]string, 0, len(e))
+	for _, err := range e {
+		parts = append(parts, fmt.Sprintf("%q: %s", err.Term, err.Message))
+	}
+
+	return "invalid search: " + strings.Join(parts, "; ")
+}
+
+// Parse reads a query, reporting every malformed term at once as Errors.
+func Parse(text string) (*Query, error) {
+	query := &Query{}
+	var errs Errors
+
+	terms, ok := split(text)
+	if !ok {
+		return nil, Errors{newError(text, "unterminated quote")}
+	}
+	if len(terms) > MaxTerms {
+		return nil, Errors{newError(text, "at most %d terms are allowed", MaxTerms)}
+	}
+
+	for _, term := range terms {
+		filter, err := parseTerm(term)
+		if err != nil {
+			errs = append(errs, *err)
+			continue
+		}
+		query.Filters = append(query.Filters, filter)
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return query, nil
+}
+
+// split breaks the query into terms at the spaces outside double quotes.
+// It reports false when a quote is left open.
+func split(text string) ([]string, bool) {
+	var terms []string
+	var term strings.Builder
+	quoted := false
+
+	for _, r := range text {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			term.WriteRune(r)
+		case !quoted && unicode.IsSpace(r):
+			if term.Len() > 0 {
+				terms = append(terms, term.String())
+				term.Reset()
+			}
+		default:
+			term.WriteRune(r)
+		}
+	}
+	if term.Len() > 0 {
+		terms = append(terms, term.String())
+	}
+
+	return terms, !quoted
+}
+
+// ParseSort reads a sort field, prefixed with "-" for descending order. An
+// empty value sorts by id.
+func ParseSort(text string) (Sort, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return Sort{Field: FieldID}, nil
+	}
+
+	sort := Sort{}
+	name := text
+	if strings.HasPrefix(name, "-") {
+		sort.Descending = true
+		name = name[1:]
+	}
+
+	sort.Field = field(name)
+	if !sortable[sort.Field] {
+		return Sort{}, Errors{newError(text, "can only sort by id, name, generation, a stat or bst")}
+	}
+
+	return sort, nil
+}
+
+func parseTerm(term string) (Filter, *Error) {
+	filter := Filter{Term: term}
+	fail := func(format string, args ...any) *Error {
+		err := newError(term, format, args...)
+		return &err
+	}
+
+	index, operator := -1, ""
+	for _, candidate := range operators {
+		if i := strings.Index(term, candidate); i > 0 && (index < 0 || i < index) {
+			index, operator = i, candidate
+		}
+	}
+	if index < 0 {
+		return filter, fail("expected field, operator and value, e.g. type:fire or speed>=100")
+	}
+
+	filter.Field = field(term[:index])
+	filter.Operator = operator
+	if operator == ":" {
+		filter.Operator = Equal
+	}
+	// Quotes only group the words of a value.
+	value := strings.ReplaceAll(term[index+len(operator):], `"`, "")
+
+	k, ok := kinds[filter.Field]
+	if !ok {
+		return filter, fail("unknown field %q", term[:index])
+	}
+	if value == "" {
+		return filter, fail("missing value")
+	}
+
+	switch k {
+	case kindString:
+		if filter.Operator != Equal && filter.Operator != NotEqual {
+			return filter, fail("%s only supports : and !=", filter.Field)
+		}
+		for _, v := range strings.Split(value, ",") {
+			if v = identifier.Normalize(v); v != "" {
+				filter.Values = append(filter.Values, v)
+			}
+		}
+		if len(filter.Values) == 0 {
+			return filter, fail("missing value")
+		}
+	case kindInt:
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return filter, fail("%s must be compared to a number", filter.Field)
+		}
+		filter.Number = n
+	case kindBool:
+		if filter.Operator != Equal && filter.Operator != NotEqual {
+			return filter, fail("%s only supports : and !=", filter.Field)
+		}
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return filter, fail("%s must be true or false", filter.Field)
+		}
+		filter.Bool = b
+	}
+
+	return filter, nil
+}
+
+func field(name string) string {
+	name = strings.ToLower(name)
+	if canonical, ok := aliases[name]; ok {
+		return canonical
+	}
+
+	return name
+}
@@ -0,0 +1,166 @@
+package search
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  []Filter
+	}{
+		{
+			name:  "two-character operators win over their prefixes",
+			query: "speed>=100 hp>50 gen<=4 bst<600",
+			want: []Filter{
+				{Term: "speed>=100", Field: FieldSpeed, Operator: GreaterOrEqual, Number: 100},
+				{Term: "hp>50", Field: FieldHP, Operator: Greater, Number: 50},
+				{Term: "gen<=4", Field: FieldGeneration, Operator: LessOrEqual, Number: 4},
+				{Term: "bst<600", Field: FieldTotal, Operator: Less, Number: 600},
+			},
+		},
+		{
+			name:  "colon and equals are the same, != negates",
+			query: "type:dragon type=ground egg!=monster legendary!=true atk!=100",
+			want: []Filter{
+				{Term: "type:dragon", Field: FieldType, Operator: Equal, Values: []string{"dragon"}},
+				{Term: "type=ground", Field: FieldType, Operator: Equal, Values: []string{"ground"}},
+				{Term: "egg!=monster", Field: FieldEggGroup, Operator: NotEqual, Values: []string{"monster"}},
+				{Term: "legendary!=true", Field: FieldLegendary, Operator: NotEqual, Bool: true},
+				{Term: "atk!=100", Field: FieldAttack, Operator: NotEqual, Number: 100},
+			},
+		},
+		{
+			name:  "aliases and display names",
+			query: "move:Earthquake SpDef>80 total>=500 type:Fire,WATER",
+			want: []Filter{
+				{Term: "move:Earthquake", Field: FieldLearns, Operator: Equal, Values: []string{"earthquake"}},
+				{Term: "SpDef>80", Field: FieldSpecialDefense, Operator: Greater, Number: 80},
+				{Term: "total>=500", Field: FieldTotal, Operator: GreaterOrEqual, Number: 500},
+				{Term: "type:Fire,WATER", Field: FieldType, Operator: Equal, Values: []string{"fire", "water"}},
+			},
+		},
+		{
+			name:  "quoted values keep their spaces",
+			query: `ability:"Sand Rush"  learns:"swords dance","dragon dance" speed>="90"`,
+			want: []Filter{
+				{Term: `ability:"Sand Rush"`, Field: FieldAbility, Operator: Equal, Values: []string{"sand-rush"}},
+				{Term: `learns:"swords dance","dragon dance"`, Field: FieldLearns, Operator: Equal, Values: []string{"swords-dance", "dragon-dance"}},
+				{Term: `speed>="90"`, Field: FieldSpeed, Operator: GreaterOrEqual, Number: 90},
+			},
+		},
+		{
+			name:  "empty query",
+			query: "   ",
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.query)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if !reflect.DeepEqual(got.Filters, tt.want) {
+				t.Errorf("Parse() = %+v, want %+v", got.Filters, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tooMany := strings.Repeat("type:fire ", MaxTerms+1)
+
+	tests := []struct {
+		name  string
+		query string
+		want  []Error
+	}{
+		{
+			name:  "every bad term is reported",
+			query: "colour:red type:fire speed>fast legendary:maybe type>=fire hp: nonsense",
+			want: []Error{
+				{Term: "colour:red", Message: `unknown field "colour"`},
+				{Term: "speed>fast", Message: "speed must be compared to a number"},
+				{Term: "legendary:maybe", Message: "legendary must be true or false"},
+				{Term: "type>=fire", Message: "type only supports : and !="},
+				{Term: "hp:", Message: "missing value"},
+				{Term: "nonsense", Message: "expected field, operator and value, e.g. type:fire or speed>=100"},
+			},
+		},
+		{
+			name:  "too many terms",
+			query: tooMany,
+			want:  []Error{{Term: tooMany, Message: "at most 20 terms are allowed"}},
+		},
+		{
+			name:  "unterminated quote",
+			query: `ability:"sand rush`,
+			want:  []Error{{Term: `ability:"sand rush`, Message: "unterminated quote"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.query)
+
+			var errs Errors
+			if !errors.As(err, &errs) {
+				t.Fatalf("Parse() error = %v, want Errors", err)
+			}
+			if len(errs) != len(tt.want) {
+				t.Fatalf("Parse() error = %v, want %d problems", err, len(tt.want))
+			}
+			for i, want := range tt.want {
+				if errs[i].Term != want.Term || errs[i].Message != want.Message {
+					t.Errorf("problem %d = %q: %s, want %q: %s", i, errs[i].Term, errs[i].Message, want.Term, want.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestMaxTerms(t *testing.T) {
+	query, err := Parse(strings.Repeat("type:fire ", MaxTerms))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(query.Filters) != MaxTerms {
+		t.Errorf("Parse() returned %d filters, want %d", len(query.Filters), MaxTerms)
+	}
+}
+
+func TestParseSort(t *testing.T) {
+	tests := []struct {
+		text string
+		want Sort
+	}{
+		{"", Sort{Field: FieldID}},
+		{"name", Sort{Field: FieldName}},
+		{"-bst", Sort{Field: FieldTotal, Descending: true}},
+		{"-Spe", Sort{Field: FieldSpeed, Descending: true}},
+		{"number", Sort{Field: FieldID}},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseSort(tt.text)
+		if err != nil {
+			t.Errorf("ParseSort(%q) error = %v", tt.text, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseSort(%q) = %+v, want %+v", tt.text, got, tt.want)
+		}
+	}
+
+	for _, text := range []string{"type", "-legendary", "--bst"} {
+		if _, err := ParseSort(text); err == nil {
+			t.Errorf("ParseSort(%q) error = nil, want an error", text)
+		}
+	}
+}
@@ -2,14 +2,20 @@ package server
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"net/http"
+	"sync/atomic"
 	"time"
 
+	"pokedex_backend_go/pkg/config"
+	"pokedex_backend_go/pkg/i18n"
 	"pokedex_backend_go/pkg/logger"
+	"pokedex_backend_go/pkg/telemetry"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
 )
@@ -25,35 +31,50 @@ func AsHandler(f interface{}) interface{} {
 
 type params struct {
 	fx.In
+	Config   *config.Config
+	DB       *sql.DB
+	Metrics  *telemetry.Metrics
+	Tracing  trace.TracerProvider
 	Handlers []func(chi.Router) `group:"handlers"`
 }
 
-func New(params params) Service {
+func New(params params) (Service, error) {
 	srvLogger := logger.NewLogger("server")
 	router := chi.NewRouter()
 
+	metrics, err := newHTTPMetrics(params.Metrics.Provider)
+	if err != nil {
+		srvLogger.Error("Failed to create HTTP metrics", zap.Error(err))
+		return nil, err
+	}
+
 	srv := &service{
-		logger: srvLogger,
+		logger:  srvLogger,
+		config:  params.Config,
+		db:      params.DB,
+		metrics: metrics,
+		tracer:  params.Tracing.Tracer(tracerName),
 		server: &http.Server{
-			Addr:    fmt.Sprintf("%s:%s", "0.0.0.0", "3000"),
+			Addr:    params.Config.Server.Addr(),
 			Handler: router,
 		},
 	}
 
-	serverTimeout, err := time.ParseDuration("60s")
-	if err != nil {
-		srv.logger.Error("Failed to parse server timeout", zap.Error(err))
-	}
-
-	router.Use(srv.RecoverMiddleware)
+	router.Use(srv.MetricsMiddleware)
+	router.Use(srv.TracingMiddleware)
 	router.Use(middleware.RealIP)
 	router.Use(middleware.RequestID)
-	router.Use(middleware.Logger)
+	router.Use(srv.AccessLogMiddleware)
+	router.Use(srv.RecoverMiddleware)
 	router.Use(middleware.StripSlashes)
+	router.Use(i18n.Middleware)
 	router.Use(srv.CorsMiddleware)
-	router.Use(middleware.Timeout(serverTimeout))
+	router.Use(middleware.Timeout(params.Config.Server.Timeout))
 
 	router.Get("/ping", pingHandler)
+	router.Get("/healthz", srv.healthHandler)
+	router.Get("/readyz", srv.readyHandler)
+	router.Method(http.MethodGet, "/metrics", params.Metrics.Handler)
 
 	router.Get("/", func(w http.ResponseWriter, r *http.Request) {
 		w.Write([]byte("Hello World!"))
@@ -64,38 +85,47 @@ func New(params params) Service {
 		router.Group(handler)
 	}
 
-	return srv
+	return srv, nil
 }
 
 type service struct {
-	logger *zap.Logger
-	server *http.Server
-	pprof  *http.Server
+	logger  *zap.Logger
+	config  *config.Config
+	db      *sql.DB
+	metrics *httpMetrics
+	tracer  trace.Tracer
+	server  *http.Server
+	pprof   *http.Server
+
+	// draining is set when Stop begins, so /readyz fails while the server
+	// still answers the requests in flight.
+	draining atomic.Bool
 }
 
 func (s *service) Start(_ context.Context) error {
 	pprofReady := make(chan struct{})
 
-	go func() {
-		router := chi.NewRouter()
-
-		router.Use(s.RecoverMiddleware)
-		router.Mount("/debug/pprof", middleware.Profiler())
-
-		pprofHost := "localhost"
-		pprofPort := "6060"
-
-		s.pprof = &http.Server{
-			Addr:    fmt.Sprintf("%s:%s", pprofHost, pprofPort),
-			Handler: router,
-		}
-
-		s.logger.Info(fmt.Sprintf("Starting pprof server on http://%s", s.pprof.Addr))
+	if s.config.Pprof.Enabled {
+		go func() {
+			router := chi.NewRouter()
+
+			router.Use(s.RecoverMiddleware)
+			router.Mount("/debug/pprof", middleware.Profiler())
+
+			s.pprof = &http.Server{
+				Addr:    s.config.Pprof.Addr(),
+				Handler: router,
+			}
+
+			s.logger.Info(fmt.Sprintf("Starting pprof server on http://%s", s.pprof.Addr))
+			close(pprofReady)
+			if err := s.pprof.ListenAndServe(); err != nil {
+				s.logger.Error("Failed to start pprof server", zap.Error(err))
+			}
+		}()
+	} else {
 		close(pprofReady)
-		if err := s.pprof.ListenAndServe(); err != nil {
-			s.logger.Error("Failed to start pprof server", zap.Error(err))
-		}
-	}()
+	}
 
 	go func() {
 		<-pprofReady
@@ -109,8 +139,17 @@ func (s *service) Start(_ context.Context) error {
 }
 
 func (s *service) Stop(ctx context.Context) error {
+	s.draining.Store(true)
 	s.logger.Info("Stopping server")
 
+	if delay := s.config.Server.DrainDelay; delay > 0 {
+		s.logger.Info(fmt.Sprintf("Draining for %s before shutting down", delay))
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+		}
+	}
+
 	if s.pprof != nil {
 		if err := s.pprof.Shutdown(ctx); err != nil {
 			s.logger.Error("Failed to stop pprof server", zap.Error(err))
@@ -0,0 +1,117 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"pokedex_backend_go/pkg/database"
+	"pokedex_backend_go/pkg/dto"
+	"pokedex_backend_go/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+// readyTimeout bounds all the readiness checks together, well below the
+// probe timeouts of the usual load balancers.
+const readyTimeout = 2 * time.Second
+
+const (
+	statusOK          = "ok"
+	statusError       = "error"
+	statusUnavailable = "unavailable"
+	statusDraining    = "draining"
+)
+
+// healthHandler answers as long as the process can serve requests.
+func (s *service) healthHandler(w http.ResponseWriter, r *http.Request) {
+	s.writeHealth(w, http.StatusOK, dto.HealthResponse{Status: statusOK})
+}
+
+// readyHandler reports whether the server should receive traffic: the
+// database answers and its schema is at the latest migration. It fails as
+// soon as Stop begins so that load balancers drain the server first.
+func (s *service) readyHandler(w http.ResponseWriter, r *http.Request) {
+	if s.draining.Load() {
+		s.writeHealth(w, http.StatusServiceUnavailable, dto.HealthResponse{Status: statusDraining})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), readyTimeout)
+	defer cancel()
+
+	checks := []readinessCheck{
+		{name: "database", message: "database is not reachable", run: s.checkDatabase},
+		{name: "migrations", message: "database is not at the latest migration", run: s.checkMigrations},
+	}
+
+	response := dto.HealthResponse{
+		Status: statusOK,
+		Checks: make(map[string]dto.HealthCheck, len(checks)),
+	}
+
+	status := http.StatusOK
+	for _, check := range checks {
+		result, err := runCheck(ctx, check.run)
+		if err != nil {
+			logger.FromContext(ctx).Warn("Readiness check failed", zap.String("check", check.name), zap.Error(err))
+			result.Error = check.message
+			response.Status = statusUnavailable
+			status = http.StatusServiceUnavailable
+		}
+		response.Checks[check.name] = result
+	}
+
+	s.writeHealth(w, status, response)
+}
+
+// readinessCheck is one of the checks of /readyz. The errors of the driver
+// and of goose can name the host, user and database, and /readyz is public,
+// so the response only carries message and the error goes to the log.
+type readinessCheck struct {
+	name    string
+	message string
+	run     func(context.Context) error
+}
+
+func (s *service) checkDatabase(ctx context.Context) error {
+	return s.db.PingContext(ctx)
+}
+
+func (s *service) checkMigrations(ctx context.Context) error {
+	current, latest, err := database.MigrationVersions(ctx, s.db)
+	if err != nil {
+		return err
+	}
+	if current < latest {
+		return fmt.Errorf("database is at migration %d, latest is %d", current, latest)
+	}
+
+	return nil
+}
+
+func runCheck(ctx context.Context, check func(context.Context) error) (dto.HealthCheck, error) {
+	start := time.Now()
+	err := check(ctx)
+
+	result := dto.HealthCheck{
+		Status:    statusOK,
+		LatencyMs: float64(time.Since(start).Microseconds()) / 1000,
+	}
+	if err != nil {
+		result.Status = statusError
+	}
+
+	return result, err
+}
+
+func (s *service) writeHealth(w http.ResponseWriter, status int, response dto.HealthResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		s.logger.Error("Failed to write health response", zap.Error(err))
+	}
+}
@@ -0,0 +1,99 @@
+package server
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+const meterName = "pokedex_backend_go/pkg/server"
+
+// durationBuckets are in seconds, from a cached lookup to a slow import.
+var durationBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60}
+
+// knownMethods keeps the method label bounded: anything else is "_OTHER".
+var knownMethods = map[string]bool{
+	http.MethodGet: true, http.MethodHead: true, http.MethodPost: true, http.MethodPut: true,
+	http.MethodPatch: true, http.MethodDelete: true, http.MethodOptions: true,
+}
+
+type httpMetrics struct {
+	requests metric.Int64Counter
+	duration metric.Float64Histogram
+	inFlight metric.Int64UpDownCounter
+}
+
+func newHTTPMetrics(provider metric.MeterProvider) (*httpMetrics, error) {
+	meter := provider.Meter(meterName)
+
+	requests, err := meter.Int64Counter("http.server.requests",
+		metric.WithDescription("Number of HTTP requests served."),
+		metric.WithUnit("{request}"))
+	if err != nil {
+		return nil, err
+	}
+
+	duration, err := meter.Float64Histogram("http.server.request.duration",
+		metric.WithDescription("Duration of HTTP requests."),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(durationBuckets...))
+	if err != nil {
+		return nil, err
+	}
+
+	inFlight, err := meter.Int64UpDownCounter("http.server.active_requests",
+		metric.WithDescription("Number of HTTP requests being served."),
+		metric.WithUnit("{request}"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &httpMetrics{requests: requests, duration: duration, inFlight: inFlight}, nil
+}
+
+// MetricsMiddleware counts and times the requests by method, chi route
+// pattern and status code. It goes first in the chain so that it sees the
+// 500 written by RecoverMiddleware, and it labels by pattern, e.g.
+// "/api/v1/pokemon/{idOrName}", rather than by path to keep the number of
+// series bounded. Requests that match no route have no http.route.
+func (s *service) MetricsMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method := semconv.HTTPRequestMethodOther
+		if knownMethods[r.Method] {
+			method = semconv.HTTPRequestMethodKey.String(r.Method)
+		}
+
+		ctx := r.Context()
+		inFlight := metric.WithAttributes(method)
+		s.metrics.inFlight.Add(ctx, 1, inFlight)
+		defer s.metrics.inFlight.Add(ctx, -1, inFlight)
+
+		start := time.Now()
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+
+		defer func() {
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+
+			attrs := []attribute.KeyValue{method, semconv.HTTPResponseStatusCode(status)}
+			if rctx := chi.RouteContext(ctx); rctx != nil {
+				if pattern := rctx.RoutePattern(); pattern != "" {
+					attrs = append(attrs, semconv.HTTPRoute(pattern))
+				}
+			}
+
+			set := metric.WithAttributes(attrs...)
+			s.metrics.requests.Add(ctx, 1, set)
+			s.metrics.duration.Record(ctx, time.Since(start).Seconds(), set)
+		}()
+
+		next.ServeHTTP(ww, r)
+	})
+}
@@ -2,14 +2,75 @@ package server
 
 import (
 	"fmt"
+	"net"
 	"net/http"
+	"time"
 
+	"pokedex_backend_go/pkg/logger"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/cors"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 )
 
+// AccessLogMiddleware puts a logger with the request ID in the request
+// context, see logger.FromContext, and logs every request once it is done.
+// It needs RealIP and RequestID before it, and goes before
+// RecoverMiddleware so that a panic is logged as a 500. The user ID added by
+// the auth middleware reaches the access log through the shared logger.
+func (s *service) AccessLogMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := logger.NewContext(r.Context(), zap.L().With(zap.String("request_id", middleware.GetReqID(r.Context()))))
+
+		start := time.Now()
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+
+		defer func() {
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+
+			var route string
+			if rctx := chi.RouteContext(ctx); rctx != nil {
+				route = rctx.RoutePattern()
+			}
+
+			level := zapcore.InfoLevel
+			if status >= http.StatusInternalServerError {
+				level = zapcore.ErrorLevel
+			}
+
+			logger.FromContext(ctx).Named("access").WithOptions(zap.WithCaller(false)).Log(level, "Request served",
+				zap.String("method", r.Method),
+				zap.String("route", route),
+				zap.String("path", r.URL.Path),
+				zap.Int("status", status),
+				zap.Int("bytes", ww.BytesWritten()),
+				zap.Duration("duration", time.Since(start)),
+				zap.String("remote_ip", remoteIP(r.RemoteAddr)),
+			)
+		}()
+
+		next.ServeHTTP(ww, r.WithContext(ctx))
+	})
+}
+
+// remoteIP drops the port that RemoteAddr has when RealIP found no header.
+func remoteIP(addr string) string {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+
+	return addr
+}
+
 func (s *service) RecoverMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
 		defer func() {
 			if r := recover(); r != nil {
 				if r == http.ErrAbortHandler {
@@ -24,7 +85,7 @@ func (s *service) RecoverMiddleware(next http.Handler) http.Handler {
 					err = fmt.Errorf("panic: %v", r)
 				}
 
-				s.logger.Error("Panic", zap.Error(err))
+				logger.FromContext(ctx).Error("Panic", zap.Error(err))
 				w.WriteHeader(http.StatusInternalServerError)
 			}
 		}()
@@ -0,0 +1,122 @@
+package showdown
+
+import (
+	"fmt"
+	"strings"
+
+	"pokedex_backend_go/pkg/model"
+)
+
+// Format writes the team as a paste Showdown can import, leaving out the
+// values Showdown assumes by default: level 100, 0 EVs and 31 IVs.
+func Format(team *Team) string {
+	var b strings.Builder
+
+	if team.Name != "" || team.Format != "" {
+		b.WriteString("=== ")
+		if team.Format != "" {
+			fmt.Fprintf(&b, "[%s] ", team.Format)
+		}
+		b.WriteString(team.Name)
+		b.WriteString(" ===\n\n")
+	}
+
+	for i, set := range team.Sets {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		writeSet(&b, &set)
+	}
+
+	return b.String()
+}
+
+func writeSet(b *strings.Builder, set *Set) {
+	if set.Nickname != "" && set.Nickname != set.Species {
+		fmt.Fprintf(b, "%s (%s)", set.Nickname, set.Species)
+	} else {
+		b.WriteString(set.Species)
+	}
+	if set.Gender != "" {
+		fmt.Fprintf(b, " (%s)", set.Gender)
+	}
+	if set.Item != "" {
+		fmt.Fprintf(b, " @ %s", set.Item)
+	}
+	b.WriteString("\n")
+
+	if set.Ability != "" {
+		fmt.Fprintf(b, "Ability: %s\n", set.Ability)
+	}
+	if set.Level != 0 && set.Level != 100 {
+		fmt.Fprintf(b, "Level: %d\n", set.Level)
+	}
+	if set.Shiny {
+		b.WriteString("Shiny: Yes\n")
+	}
+	if set.TeraType != "" {
+		fmt.Fprintf(b, "Tera Type: %s\n", set.TeraType)
+	}
+	if set.EVs != nil {
+		if line := formatSpread(*set.EVs, 0); line != "" {
+			fmt.Fprintf(b, "EVs: %s\n", line)
+		}
+	}
+	if set.Nature != "" {
+		fmt.Fprintf(b, "%s Nature\n", set.Nature)
+	}
+	if set.IVs != nil {
+		if line := formatSpread(*set.IVs, 31); line != "" {
+			fmt.Fprintf(b, "IVs: %s\n", line)
+		}
+	}
+	for _, move := range set.Moves {
+		fmt.Fprintf(b, "- %s\n", move)
+	}
+}
+
+func formatSpread(spread model.StatSpread, skip int) string {
+	var parts []string
+	for _, s := range stats {
+		if value := *s.field(&spread); value != skip {
+			parts = append(parts, fmt.Sprintf("%d %s", value, s.name))
+		}
+	}
+
+	return strings.Join(parts, " / ")
+}
+
+// DisplayName turns a catalog identifier into the name Showdown shows, e.g.
+// "choice-band" into "Choice Band". Showdown matches names ignoring case and
+// punctuation, so the result imports back even when it differs slightly from
+// the official spelling.
+func DisplayName(identifier string) string {
+	return titleCase(identifier, " ")
+}
+
+// PokemonName names a variety the way Showdown does: the species name
+// followed by the form, e.g. "Great Tusk" or "Rotom-Wash". Default
+// varieties go by the species name alone, so mimikyu-disguised is "Mimikyu".
+func PokemonName(species, pokemon string, isDefault bool) string {
+	if isDefault {
+		return DisplayName(species)
+	}
+
+	form := strings.TrimPrefix(pokemon, species)
+	if form == pokemon {
+		return DisplayName(pokemon)
+	}
+
+	return DisplayName(species) + titleCase(form, "-")
+}
+
+func titleCase(identifier, separator string) string {
+	words := strings.Split(identifier, "-")
+	for i, word := range words {
+		if word != "" {
+			words[i] = strings.ToUpper(word[:1]) + word[1:]
+		}
+	}
+
+	return strings.Join(words, separator)
+}
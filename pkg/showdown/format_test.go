@@ -0,0 +1,110 @@
+package showdown
+
+import (
+	"testing"
+
+	"pokedex_backend_go/pkg/model"
+)
+
+func TestFormat(t *testing.T) {
+	team := &Team{
+		Format: "gen9ou",
+		Name:   "Rain",
+		Sets: []Set{
+			{
+				Nickname: "Birb",
+				Species:  "Pelipper",
+				Gender:   "F",
+				Item:     "Damp Rock",
+				Ability:  "Drizzle",
+				Level:    50,
+				Shiny:    true,
+				TeraType: "Ground",
+				EVs:      &model.StatSpread{HP: 248, Defense: 252, SpecialDefense: 8},
+				IVs:      &model.StatSpread{HP: 31, Attack: 0, Defense: 31, SpecialAttack: 31, SpecialDefense: 31, Speed: 31},
+				Nature:   "Bold",
+				Moves:    []string{"Hurricane", "Scald"},
+			},
+			{
+				// Level 100, no EVs and 31 IVs are Showdown's defaults and
+				// are left out.
+				Nickname: "Barraskewda",
+				Species:  "Barraskewda",
+				Level:    100,
+				EVs:      &model.StatSpread{},
+				IVs:      &model.StatSpread{HP: 31, Attack: 31, Defense: 31, SpecialAttack: 31, SpecialDefense: 31, Speed: 31},
+				Moves:    []string{"Liquidation"},
+			},
+		},
+	}
+
+	want := "=== [gen9ou] Rain ===\n\n" +
+		"Birb (Pelipper) (F) @ Damp Rock\n" +
+		"Ability: Drizzle\n" +
+		"Level: 50\n" +
+		"Shiny: Yes\n" +
+		"Tera Type: Ground\n" +
+		"EVs: 248 HP / 252 Def / 8 SpD\n" +
+		"Bold Nature\n" +
+		"IVs: 0 Atk\n" +
+		"- Hurricane\n" +
+		"- Scald\n" +
+		"\n" +
+		"Barraskewda\n" +
+		"- Liquidation\n"
+
+	if got := Format(team); got != want {
+		t.Errorf("Format() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestFormatRoundTrip(t *testing.T) {
+	paste := "=== [gen9vgc2024regh] Sun ===\n\n" +
+		"Sunny (Torkoal) (M) @ Charcoal\n" +
+		"Ability: Drought\n" +
+		"Level: 50\n" +
+		"Tera Type: Fire\n" +
+		"EVs: 252 HP / 252 SpA / 4 SpD\n" +
+		"Quiet Nature\n" +
+		"IVs: 0 Atk / 0 Spe\n" +
+		"- Eruption\n" +
+		"- Heat Wave\n" +
+		"- Protect\n" +
+		"\n" +
+		"Lilligant-Hisui @ Focus Sash\n" +
+		"Ability: Chlorophyll\n" +
+		"Shiny: Yes\n" +
+		"EVs: 252 Atk / 4 SpD / 252 Spe\n" +
+		"Jolly Nature\n" +
+		"- Close Combat\n" +
+		"- Leaf Blade\n"
+
+	team, err := Parse(paste)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if got := Format(team); got != paste {
+		t.Errorf("Format(Parse()) =\n%s\nwant\n%s", got, paste)
+	}
+}
+
+func TestPokemonName(t *testing.T) {
+	tests := []struct {
+		species   string
+		pokemon   string
+		isDefault bool
+		want      string
+	}{
+		{"great-tusk", "great-tusk", true, "Great Tusk"},
+		{"rotom", "rotom-wash", false, "Rotom-Wash"},
+		{"mimikyu", "mimikyu-disguised", true, "Mimikyu"},
+		{"landorus", "landorus-therian", false, "Landorus-Therian"},
+	}
+
+	for _, tt := range tests {
+		if got := PokemonName(tt.species, tt.pokemon, tt.isDefault); got != tt.want {
+			t.Errorf("PokemonName(%q, %q, %t) = %q, want %q", tt.species, tt.pokemon, tt.isDefault, got, tt.want)
+		}
+	}
+}
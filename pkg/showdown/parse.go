@@ -0,0 +1,201 @@
+package showdown
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"pokedex_backend_go/pkg/model"
+)
+
+// Parse reads a paste. It is lenient: lines it does not understand, such as
+// "Happiness: 255" or "Dynamax Level: 10", are ignored, and only malformed
+// values of the lines it uses are reported, all at once, as Errors.
+func Parse(text string) (*Team, error) {
+	p := &parser{team: &Team{}}
+
+	for i, line := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		p.line(i+1, strings.TrimSpace(line))
+	}
+	p.finish()
+
+	if len(p.errs) > 0 {
+		return p.team, p.errs
+	}
+
+	return p.team, nil
+}
+
+type parser struct {
+	team    *Team
+	current *Set
+	errs    Errors
+}
+
+func (p *parser) fail(line int, format string, args ...any) {
+	p.errs = append(p.errs, Error{Line: line, Message: fmt.Sprintf(format, args...), Format: format, Args: args})
+}
+
+func (p *parser) finish() {
+	if p.current != nil {
+		p.team.Sets = append(p.team.Sets, *p.current)
+		p.current = nil
+	}
+}
+
+func (p *parser) line(n int, line string) {
+	switch {
+	case line == "":
+		p.finish()
+	case strings.HasPrefix(line, "==="):
+		p.finish()
+		p.header(line)
+	case p.current == nil:
+		p.current = &Set{}
+		p.nameLine(n, line)
+	case strings.HasPrefix(line, "- "), strings.HasPrefix(line, "~ "):
+		p.move(n, strings.TrimSpace(line[2:]))
+	case strings.HasSuffix(line, " Nature"):
+		p.current.Nature = strings.TrimSpace(strings.TrimSuffix(line, " Nature"))
+		p.current.Lines.Nature = n
+	default:
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return
+		}
+		p.attribute(n, strings.TrimSpace(key), strings.TrimSpace(value))
+	}
+}
+
+// header reads "=== [gen9ou] Rain ===", with both the format and the name
+// optional.
+func (p *parser) header(line string) {
+	line = strings.TrimSpace(strings.Trim(line, "="))
+	if strings.HasPrefix(line, "[") {
+		if end := strings.Index(line, "]"); end > 0 {
+			p.team.Format = line[1:end]
+			line = strings.TrimSpace(line[end+1:])
+		}
+	}
+	p.team.Name = line
+}
+
+// nameLine reads the first line of a set: "Nickname (Species) (M) @ Item",
+// where everything but the species is optional.
+func (p *parser) nameLine(n int, line string) {
+	set := p.current
+	set.Lines.Species = n
+
+	if name, item, ok := cutLast(line, " @ "); ok {
+		line = strings.TrimSpace(name)
+		set.Item = strings.TrimSpace(item)
+		set.Lines.Item = n
+	}
+
+	for _, gender := range []string{"M", "F"} {
+		if strings.HasSuffix(line, " ("+gender+")") {
+			set.Gender = gender
+			line = strings.TrimSpace(strings.TrimSuffix(line, " ("+gender+")"))
+		}
+	}
+
+	if strings.HasSuffix(line, ")") {
+		if open := strings.LastIndex(line, " ("); open > 0 {
+			set.Nickname = strings.TrimSpace(line[:open])
+			line = line[open+2 : len(line)-1]
+		}
+	}
+
+	set.Species = strings.TrimSpace(line)
+	if set.Species == "" {
+		p.fail(n, "missing species")
+	}
+}
+
+func (p *parser) move(n int, name string) {
+	// "Hidden Power [Fire]" is the move hidden-power with a type attached.
+	if open := strings.Index(name, "["); open > 0 {
+		name = strings.TrimSpace(name[:open])
+	}
+	if name == "" {
+		p.fail(n, "missing move name")
+		return
+	}
+
+	p.current.Moves = append(p.current.Moves, name)
+	p.current.Lines.Moves = append(p.current.Lines.Moves, n)
+}
+
+func (p *parser) attribute(n int, key, value string) {
+	set := p.current
+
+	switch key {
+	case "Ability":
+		set.Ability = value
+		set.Lines.Ability = n
+	case "Level":
+		level, err := strconv.Atoi(value)
+		if err != nil {
+			p.fail(n, "level must be a number")
+			return
+		}
+		set.Level = level
+		set.Lines.Level = n
+	case "Shiny":
+		set.Shiny = strings.EqualFold(value, "yes")
+	case "Tera Type":
+		set.TeraType = value
+	case "EVs":
+		set.EVs = p.spread(n, value, model.StatSpread{})
+		set.Lines.EVs = n
+	case "IVs":
+		set.IVs = p.spread(n, value, model.StatSpread{HP: 31, Attack: 31, Defense: 31, SpecialAttack: 31, SpecialDefense: 31, Speed: 31})
+		set.Lines.IVs = n
+	}
+}
+
+// spread reads "252 Atk / 4 SpD / 252 Spe" on top of the given defaults,
+// since pastes only list the values that differ from them.
+func (p *parser) spread(n int, value string, spread model.StatSpread) *model.StatSpread {
+	for _, part := range strings.Split(value, "/") {
+		amount, stat, ok := strings.Cut(strings.TrimSpace(part), " ")
+		if !ok {
+			p.fail(n, "invalid stat %q", strings.TrimSpace(part))
+			continue
+		}
+
+		number, err := strconv.Atoi(amount)
+		if err != nil {
+			p.fail(n, "invalid stat value %q", amount)
+			continue
+		}
+
+		field := statField(&spread, strings.TrimSpace(stat))
+		if field == nil {
+			p.fail(n, "unknown stat %q", strings.TrimSpace(stat))
+			continue
+		}
+		*field = number
+	}
+
+	return &spread
+}
+
+func statField(spread *model.StatSpread, name string) *int {
+	for _, s := range stats {
+		if strings.EqualFold(s.name, name) {
+			return s.field(spread)
+		}
+	}
+
+	return nil
+}
+
+func cutLast(s, sep string) (before, after string, found bool) {
+	i := strings.LastIndex(s, sep)
+	if i < 0 {
+		return s, "", false
+	}
+
+	return s[:i], s[i+len(sep):], true
+}
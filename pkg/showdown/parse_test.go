@@ -0,0 +1,131 @@
+package showdown
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"pokedex_backend_go/pkg/model"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name  string
+		paste string
+		want  *Team
+	}{
+		{
+			name:  "species only, defaults left unset",
+			paste: "Great Tusk\n- Headlong Rush",
+			want: &Team{Sets: []Set{{
+				Species: "Great Tusk",
+				Moves:   []string{"Headlong Rush"},
+				Lines:   Lines{Species: 1, Moves: []int{2}},
+			}}},
+		},
+		{
+			name: "nickname, gender, item and every attribute",
+			paste: "Birb (Pelipper) (F) @ Damp Rock\n" +
+				"Ability: Drizzle\n" +
+				"Level: 50\n" +
+				"Shiny: Yes\n" +
+				"Tera Type: Ground\n" +
+				"EVs: 248 HP / 252 Def / 8 SpD\n" +
+				"Bold Nature\n" +
+				"IVs: 0 Atk\n" +
+				"- Hurricane\n" +
+				"- Scald",
+			want: &Team{Sets: []Set{{
+				Nickname: "Birb",
+				Species:  "Pelipper",
+				Gender:   "F",
+				Item:     "Damp Rock",
+				Ability:  "Drizzle",
+				Level:    50,
+				Shiny:    true,
+				TeraType: "Ground",
+				EVs:      &model.StatSpread{HP: 248, Defense: 252, SpecialDefense: 8},
+				IVs:      &model.StatSpread{HP: 31, Attack: 0, Defense: 31, SpecialAttack: 31, SpecialDefense: 31, Speed: 31},
+				Nature:   "Bold",
+				Moves:    []string{"Hurricane", "Scald"},
+				Lines:    Lines{Species: 1, Item: 1, Ability: 2, Level: 3, EVs: 6, Nature: 7, IVs: 8, Moves: []int{9, 10}},
+			}}},
+		},
+		{
+			name:  "species with a form and a gender but no nickname",
+			paste: "Rotom-Wash (M) @ Leftovers",
+			want: &Team{Sets: []Set{{
+				Species: "Rotom-Wash",
+				Gender:  "M",
+				Item:    "Leftovers",
+				Lines:   Lines{Species: 1, Item: 1},
+			}}},
+		},
+		{
+			name:  "Hidden Power drops its type",
+			paste: "Magnezone\n- Hidden Power [Fire]\n~ Thunderbolt",
+			want: &Team{Sets: []Set{{
+				Species: "Magnezone",
+				Moves:   []string{"Hidden Power", "Thunderbolt"},
+				Lines:   Lines{Species: 1, Moves: []int{2, 3}},
+			}}},
+		},
+		{
+			name: "header, blank lines between members and ignored lines",
+			paste: "=== [gen9ou] Rain ===\n\n" +
+				"Pelipper @ Damp Rock\r\n" +
+				"Happiness: 255\n" +
+				"Dynamax Level: 10\n" +
+				"\n\n" +
+				"Barraskewda\n" +
+				"- Liquidation\n",
+			want: &Team{Format: "gen9ou", Name: "Rain", Sets: []Set{
+				{Species: "Pelipper", Item: "Damp Rock", Lines: Lines{Species: 3, Item: 3}},
+				{Species: "Barraskewda", Moves: []string{"Liquidation"}, Lines: Lines{Species: 8, Moves: []int{9}}},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.paste)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	paste := "Garchomp\n" +
+		"Level: fifty\n" +
+		"EVs: 252 Atk / lots Spe / 4 Foo / 4\n"
+
+	_, err := Parse(paste)
+
+	var errs Errors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Parse() error = %v, want Errors", err)
+	}
+
+	want := []struct {
+		line    int
+		message string
+	}{
+		{2, "level must be a number"},
+		{3, `invalid stat value "lots"`},
+		{3, `unknown stat "Foo"`},
+		{3, `invalid stat "4"`},
+	}
+	if len(errs) != len(want) {
+		t.Fatalf("Parse() errors = %v, want %d", errs, len(want))
+	}
+	for i, w := range want {
+		if errs[i].Line != w.line || errs[i].Message != w.message {
+			t.Errorf("errors[%d] = line %d %q, want line %d %q", i, errs[i].Line, errs[i].Message, w.line, w.message)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+// Package showdown reads and writes teams in the Pokémon Showdown text
+// format:
+//
+//	Pelipper @ Damp Rock
+//	Ability: Drizzle
+//	EVs: 248 HP / 252 Def / 8 SpD
+//	Bold Nature
+//	- Hurricane
+//	- Scald
+//
+// Names are kept as written; resolving them against the catalog is up to the
+// caller, which can point at the offending line through Set.Lines.
+package showdown
+
+import (
+	"fmt"
+	"strings"
+
+	"pokedex_backend_go/pkg/model"
+)
+
+// Team is a parsed paste. Format and Name come from an optional
+// "=== [format] name ===" header.
+type Team struct {
+	Format string
+	Name   string
+	Sets   []Set
+}
+
+// Set is a single pokemon of a paste. Level is 0 when the paste omits it,
+// which Showdown treats as level 100, and EVs and IVs are nil when omitted.
+type Set struct {
+	Nickname string
+	Species  string
+	Gender   string
+	Item     string
+	Ability  string
+	Level    int
+	Shiny    bool
+	TeraType string
+	EVs      *model.StatSpread
+	IVs      *model.StatSpread
+	Nature   string
+	Moves    []string
+	Lines    Lines
+}
+
+// Lines holds the 1-based line numbers each attribute of a Set was read
+// from, or 0 when the paste did not set it.
+type Lines struct {
+	Species int
+	Item    int
+	Ability int
+	Level   int
+	EVs     int
+	IVs     int
+	Nature  int
+	Moves   []int
+}
+
+// Error is a syntax problem on a line of the paste. Format and Args are
+// what Message was rendered from, so it can be translated.
+type Error struct {
+	Line    int
+	Message string
+	Format  string
+	Args    []any
+}
+
+// Errors lists every syntax problem of a paste.
+type Errors []Error
+
+func (e Errors) Error() string {
+	parts := make([]string, 0, len(e))
+	for _, err := range e {
+		parts = append(parts, fmt.Sprintf("line %d: %s", err.Line, err.Message))
+	}
+
+	return "invalid paste: " + strings.Join(parts, "; ")
+}
+
+// stats maps the abbreviations used on EV and IV lines to the fields of a
+// StatSpread, in Showdown's order.
+var stats = []struct {
+	name  string
+	field func(s *model.StatSpread) *int
+}{
+	{"HP", func(s *model.StatSpread) *int { return &s.HP }},
+	{"Atk", func(s *model.StatSpread) *int { return &s.Attack }},
+	{"Def", func(s *model.StatSpread) *int { return &s.Defense }},
+	{"SpA", func(s *model.StatSpread) *int { return &s.SpecialAttack }},
+	{"SpD", func(s *model.StatSpread) *int { return &s.SpecialDefense }},
+	{"Spe", func(s *model.StatSpread) *int { return &s.Speed }},
+}
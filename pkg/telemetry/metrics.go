@@ -0,0 +1,70 @@
+package telemetry
+
+import (
+	"context"
+	"net/http"
+
+	"pokedex_backend_go/pkg/config"
+	"pokedex_backend_go/pkg/logger"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel"
+	otelprometheus "go.opentelemetry.io/otel/exporters/prometheus"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// Metrics is the MeterProvider of the API and the handler that serves its
+// metrics to Prometheus.
+type Metrics struct {
+	Provider metric.MeterProvider
+	Handler  http.Handler
+}
+
+// NewMetrics creates a MeterProvider read by a Prometheus exporter. The
+// exporter uses its own registry, together with the Go runtime and process
+// collectors, rather than the global one of client_golang.
+func NewMetrics(lc fx.Lifecycle, cfg *config.Config) (*Metrics, error) {
+	metricsLogger := logger.NewLogger("metrics")
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+
+	exporter, err := otelprometheus.New(otelprometheus.WithRegisterer(registry))
+	if err != nil {
+		metricsLogger.Error("failed to create prometheus exporter", zap.Error(err))
+		return nil, err
+	}
+
+	res, err := newResource(cfg)
+	if err != nil {
+		metricsLogger.Error("failed to create telemetry resource", zap.Error(err))
+		return nil, err
+	}
+
+	provider := sdkmetric.NewMeterProvider(
+		sdkmetric.WithReader(exporter),
+		sdkmetric.WithResource(res),
+	)
+	otel.SetMeterProvider(provider)
+
+	lc.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			return provider.Shutdown(ctx)
+		},
+	})
+
+	return &Metrics{
+		Provider: provider,
+		Handler: promhttp.HandlerFor(registry, promhttp.HandlerOpts{
+			ErrorLog: zap.NewStdLog(metricsLogger),
+		}),
+	}, nil
+}
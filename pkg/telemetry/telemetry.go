@@ -0,0 +1,29 @@
+// Package telemetry sets up OpenTelemetry for the API. The providers are
+// also installed as the global ones, so the instrumentation of libraries
+// such as otelsql, which asks otel for them, is exported too.
+package telemetry
+
+import (
+	"pokedex_backend_go/pkg/config"
+
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.uber.org/fx"
+)
+
+func TelemetryProvider() fx.Option {
+	return fx.Options(
+		fx.Provide(
+			NewMetrics,
+			NewTracerProvider,
+		),
+	)
+}
+
+// newResource describes the process to the exporters.
+func newResource(cfg *config.Config) (*resource.Resource, error) {
+	return resource.Merge(
+		resource.Default(),
+		resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(cfg.App.Name)),
+	)
+}
@@ -0,0 +1,102 @@
+package telemetry
+
+import (
+	"context"
+
+	"pokedex_backend_go/pkg/config"
+	"pokedex_backend_go/pkg/logger"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// NewTracerProvider creates the TracerProvider of the API with the exporter
+// of tracing.exporter and installs it, together with the W3C trace context
+// and baggage propagators, as the global one. With the "none" exporter
+// spans are still created, so trace IDs reach the logs and are passed on,
+// but they are not recorded.
+func NewTracerProvider(lc fx.Lifecycle, cfg *config.Config) (trace.TracerProvider, error) {
+	tracingLogger := logger.NewLogger("tracing")
+
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+		tracingLogger.Warn("OpenTelemetry error", zap.Error(err))
+	}))
+
+	exporter, err := newSpanExporter(cfg.Tracing)
+	if err != nil {
+		tracingLogger.Error("failed to create span exporter", zap.String("exporter", cfg.Tracing.Exporter), zap.Error(err))
+		return nil, err
+	}
+
+	res, err := newResource(cfg)
+	if err != nil {
+		tracingLogger.Error("failed to create telemetry resource", zap.Error(err))
+		return nil, err
+	}
+
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.Tracing.SampleRatio))),
+	}
+	if exporter != nil {
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	}
+
+	provider := sdktrace.NewTracerProvider(opts...)
+	otel.SetTracerProvider(provider)
+
+	tracingLogger.Info("tracing configured",
+		zap.String("exporter", cfg.Tracing.Exporter),
+		zap.Float64("sample_ratio", cfg.Tracing.SampleRatio))
+
+	lc.Append(fx.Hook{
+		// Shutdown flushes the spans still in the batch.
+		OnStop: func(ctx context.Context) error {
+			return provider.Shutdown(ctx)
+		},
+	})
+
+	return provider, nil
+}
+
+// newSpanExporter returns nil for the "none" exporter. The OTLP exporters
+// connect lazily, so a collector that is down does not stop the API.
+func newSpanExporter(cfg config.TracingConfig) (sdktrace.SpanExporter, error) {
+	ctx := context.Background()
+
+	switch cfg.Exporter {
+	case "stdout":
+		return stdouttrace.New()
+	case "otlp-grpc":
+		var opts []otlptracegrpc.Option
+		if cfg.Endpoint != "" {
+			opts = append(opts, otlptracegrpc.WithEndpoint(cfg.Endpoint))
+		}
+		if cfg.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		return otlptracegrpc.New(ctx, opts...)
+	case "otlp-http":
+		var opts []otlptracehttp.Option
+		if cfg.Endpoint != "" {
+			opts = append(opts, otlptracehttp.WithEndpoint(cfg.Endpoint))
+		}
+		if cfg.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		return otlptracehttp.New(ctx, opts...)
+	}
+
+	return nil, nil
+}
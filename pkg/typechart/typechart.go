@@ -0,0 +1,243 @@
+// Package typechart holds the type effectiveness chart of every generation.
+// Types are named by their PokeAPI identifiers ("fire", "fairy", ...).
+package typechart
+
+import (
+	"net/http"
+
+	"pokedex_backend_go/pkg/i18n"
+	"pokedex_backend_go/pkg/problem"
+)
+
+const (
+	FirstGeneration  = 1
+	LatestGeneration = 9
+)
+
+var (
+	ErrUnknownGeneration = problem.New(http.StatusBadRequest, "unknown_generation", "unknown generation")
+	ErrUnknownType       = problem.New(http.StatusBadRequest, "unknown_type", "unknown type")
+)
+
+// allTypes lists every type in PokeAPI id order, with the generation that
+// introduced it.
+var allTypes = []struct {
+	name       string
+	introduced int
+}{
+	{"normal", 1},
+	{"fighting", 1},
+	{"flying", 1},
+	{"poison", 1},
+	{"ground", 1},
+	{"rock", 1},
+	{"bug", 1},
+	{"ghost", 1},
+	{"steel", 2},
+	{"fire", 1},
+	{"water", 1},
+	{"grass", 1},
+	{"electric", 1},
+	{"psychic", 1},
+	{"ice", 1},
+	{"dragon", 1},
+	{"dark", 2},
+	{"fairy", 6},
+}
+
+// current holds the multipliers of the latest generation that differ from 1,
+// indexed by attacking and then defending type.
+var current = map[string]map[string]float64{
+	"normal":   {"rock": 0.5, "ghost": 0, "steel": 0.5},
+	"fire":     {"fire": 0.5, "water": 0.5, "grass": 2, "ice": 2, "bug": 2, "rock": 0.5, "dragon": 0.5, "steel": 2},
+	"water":    {"fire": 2, "water": 0.5, "grass": 0.5, "ground": 2, "rock": 2, "dragon": 0.5},
+	"electric": {"water": 2, "electric": 0.5, "grass": 0.5, "ground": 0, "flying": 2, "dragon": 0.5},
+	"grass":    {"fire": 0.5, "water": 2, "grass": 0.5, "poison": 0.5, "ground": 2, "flying": 0.5, "bug": 0.5, "rock": 2, "dragon": 0.5, "steel": 0.5},
+	"ice":      {"fire": 0.5, "water": 0.5, "grass": 2, "ice": 0.5, "ground": 2, "flying": 2, "dragon": 2, "steel": 0.5},
+	"fighting": {"normal": 2, "ice": 2, "poison": 0.5, "flying": 0.5, "psychic": 0.5, "bug": 0.5, "rock": 2, "ghost": 0, "dark": 2, "steel": 2, "fairy": 0.5},
+	"poison":   {"grass": 2, "poison": 0.5, "ground": 0.5, "rock": 0.5, "ghost": 0.5, "steel": 0, "fairy": 2},
+	"ground":   {"fire": 2, "electric": 2, "grass": 0.5, "poison": 2, "flying": 0, "bug": 0.5, "rock": 2, "steel": 2},
+	"flying":   {"electric": 0.5, "grass": 2, "fighting": 2, "bug": 2, "rock": 0.5, "steel": 0.5},
+	"psychic":  {"fighting": 2, "poison": 2, "psychic": 0.5, "dark": 0, "steel": 0.5},
+	"bug":      {"fire": 0.5, "grass": 2, "fighting": 0.5, "poison": 0.5, "flying": 0.5, "psychic": 2, "ghost": 0.5, "dark": 2, "steel": 0.5, "fairy": 0.5},
+	"rock":     {"fire": 2, "ice": 2, "fighting": 0.5, "ground": 0.5, "flying": 2, "bug": 2, "steel": 0.5},
+	"ghost":    {"normal": 0, "psychic": 2, "ghost": 2, "dark": 0.5},
+	"dragon":   {"dragon": 2, "steel": 0.5, "fairy": 0},
+	"dark":     {"fighting": 0.5, "psychic": 2, "ghost": 2, "dark": 0.5, "fairy": 0.5},
+	"steel":    {"fire": 0.5, "water": 0.5, "electric": 0.5, "ice": 2, "rock": 2, "steel": 0.5, "fairy": 2},
+	"fairy":    {"fire": 0.5, "fighting": 2, "poison": 0.5, "dragon": 2, "dark": 2, "steel": 0.5},
+}
+
+type override struct {
+	attacking, defending string
+	factor               float64
+	// until is the last generation the override applies to.
+	until int
+}
+
+// past lists the matchups that changed over time. Overrides for older
+// generations take precedence, so they are checked in order.
+var past = []override{
+	// Generation 1 quirks, fixed in generation 2.
+	{"bug", "poison", 2, 1},
+	{"poison", "bug", 2, 1},
+	{"ghost", "psychic", 0, 1},
+	{"ice", "fire", 1, 1},
+	// Steel resisted Ghost and Dark until Fairy was introduced.
+	{"ghost", "steel", 0.5, 5},
+	{"dark", "steel", 0.5, 5},
+}
+
+// Chart is the type chart of one generation.
+type Chart struct {
+	generation int
+	types      []string
+	factors    map[string]map[string]float64
+}
+
+var charts = func() map[int]*Chart {
+	charts := make(map[int]*Chart, LatestGeneration)
+	for gen := FirstGeneration; gen <= LatestGeneration; gen++ {
+		charts[gen] = build(gen)
+	}
+	return charts
+}()
+
+func build(generation int) *Chart {
+	chart := &Chart{
+		generation: generation,
+		factors:    make(map[string]map[string]float64),
+	}
+
+	for _, t := range allTypes {
+		if t.introduced <= generation {
+			chart.types = append(chart.types, t.name)
+		}
+	}
+
+	for _, attacking := range chart.types {
+		row := make(map[string]float64, len(chart.types))
+		for _, defending := range chart.types {
+			factor, ok := current[attacking][defending]
+			if !ok {
+				factor = 1
+			}
+			row[defending] = factor
+		}
+		chart.factors[attacking] = row
+	}
+
+	// Apply the newest overrides first so the oldest ones win.
+	for i := len(past) - 1; i >= 0; i-- {
+		o := past[i]
+		if generation > o.until {
+			continue
+		}
+		if row, ok := chart.factors[o.attacking]; ok {
+			if _, ok := row[o.defending]; ok {
+				row[o.defending] = o.factor
+			}
+		}
+	}
+
+	return chart
+}
+
+// ForGeneration returns the chart of the given generation.
+func ForGeneration(generation int) (*Chart, error) {
+	chart, ok := charts[generation]
+	if !ok {
+		return nil, i18n.Errorf(ErrUnknownGeneration, "unknown generation %d", generation)
+	}
+
+	return chart, nil
+}
+
+// Latest returns the chart of the latest generation.
+func Latest() *Chart {
+	return charts[LatestGeneration]
+}
+
+func (c *Chart) Generation() int {
+	return c.generation
+}
+
+// Types returns the types that exist in the generation, in PokeAPI order.
+func (c *Chart) Types() []string {
+	return append([]string(nil), c.types...)
+}
+
+func (c *Chart) Has(name string) bool {
+	_, ok := c.factors[name]
+	return ok
+}
+
+// Factor returns the multiplier of an attacking type against a single
+// defending type.
+func (c *Chart) Factor(attacking, defending string) (float64, error) {
+	row, ok := c.factors[attacking]
+	if !ok {
+		return 0, c.unknown(attacking)
+	}
+
+	factor, ok := row[defending]
+	if !ok {
+		return 0, c.unknown(defending)
+	}
+
+	return factor, nil
+}
+
+// Effectiveness returns the multiplier of an attacking type against a
+// pokemon with the given types, i.e. the product of the single-type factors.
+func (c *Chart) Effectiveness(attacking string, defending ...string) (float64, error) {
+	multiplier := 1.0
+	for _, d := range defending {
+		factor, err := c.Factor(attacking, d)
+		if err != nil {
+			return 0, err
+		}
+		multiplier *= factor
+	}
+
+	return multiplier, nil
+}
+
+// Defensive returns the multiplier every attacking type has against a
+// pokemon with the given types.
+func (c *Chart) Defensive(defending ...string) (map[string]float64, error) {
+	multipliers := make(map[string]float64, len(c.types))
+	for _, attacking := range c.types {
+		multiplier, err := c.Effectiveness(attacking, defending...)
+		if err != nil {
+			return nil, err
+		}
+		multipliers[attacking] = multiplier
+	}
+
+	return multipliers, nil
+}
+
+// Offensive returns, for every defending type, the best multiplier any of the
+// attacking types reaches against it.
+func (c *Chart) Offensive(attacking ...string) (map[string]float64, error) {
+	best := make(map[string]float64, len(c.types))
+	for _, defending := range c.types {
+		best[defending] = 0
+		for _, a := range attacking {
+			factor, err := c.Factor(a, defending)
+			if err != nil {
+				return nil, err
+			}
+			if factor > best[defending] {
+				best[defending] = factor
+			}
+		}
+	}
+
+	return best, nil
+}
+
+func (c *Chart) unknown(name string) error {
+	return i18n.Errorf(ErrUnknownType, "unknown type %q in generation %d", name, c.generation)
+}
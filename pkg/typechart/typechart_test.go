@@ -0,0 +1,110 @@
+package typechart
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFactor(t *testing.T) {
+	tests := []struct {
+		name                 string
+		generation           int
+		attacking, defending string
+		want                 float64
+	}{
+		{"gen 1 Ghost cannot hit Psychic", 1, "ghost", "psychic", 0},
+		{"gen 1 Bug hits Poison", 1, "bug", "poison", 2},
+		{"gen 1 Poison hits Bug", 1, "poison", "bug", 2},
+		{"gen 1 Fire does not resist Ice", 1, "ice", "fire", 1},
+		{"gen 2 Ghost hits Psychic", 2, "ghost", "psychic", 2},
+		{"gen 2 Bug is resisted by Poison", 2, "bug", "poison", 0.5},
+		{"gen 2 Fire resists Ice", 2, "ice", "fire", 0.5},
+		{"gen 2 Steel resists Ghost", 2, "ghost", "steel", 0.5},
+		{"gen 5 Steel resists Ghost", 5, "ghost", "steel", 0.5},
+		{"gen 5 Steel resists Dark", 5, "dark", "steel", 0.5},
+		{"gen 6 Steel no longer resists Ghost", 6, "ghost", "steel", 1},
+		{"gen 6 Steel no longer resists Dark", 6, "dark", "steel", 1},
+		{"gen 9 Dragon cannot hit Fairy", 9, "dragon", "fairy", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chart, err := ForGeneration(tt.generation)
+			if err != nil {
+				t.Fatalf("ForGeneration(%d) error = %v", tt.generation, err)
+			}
+
+			got, err := chart.Factor(tt.attacking, tt.defending)
+			if err != nil {
+				t.Fatalf("Factor() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Factor(%q, %q) = %v, want %v", tt.attacking, tt.defending, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTypesByGeneration(t *testing.T) {
+	tests := []struct {
+		generation int
+		count      int
+		steel      bool
+		fairy      bool
+	}{
+		{1, 15, false, false},
+		{2, 17, true, false},
+		{5, 17, true, false},
+		{6, 18, true, true},
+		{9, 18, true, true},
+	}
+
+	for _, tt := range tests {
+		chart, err := ForGeneration(tt.generation)
+		if err != nil {
+			t.Fatalf("ForGeneration(%d) error = %v", tt.generation, err)
+		}
+		if got := len(chart.Types()); got != tt.count {
+			t.Errorf("gen %d has %d types, want %d", tt.generation, got, tt.count)
+		}
+		if got := chart.Has("steel"); got != tt.steel {
+			t.Errorf("gen %d Has(steel) = %t, want %t", tt.generation, got, tt.steel)
+		}
+		if got := chart.Has("fairy"); got != tt.fairy {
+			t.Errorf("gen %d Has(fairy) = %t, want %t", tt.generation, got, tt.fairy)
+		}
+	}
+
+	chart, _ := ForGeneration(5)
+	if _, err := chart.Factor("fairy", "dragon"); !errors.Is(err, ErrUnknownType) {
+		t.Errorf("gen 5 Factor(fairy, dragon) error = %v, want ErrUnknownType", err)
+	}
+
+	if _, err := ForGeneration(10); !errors.Is(err, ErrUnknownGeneration) {
+		t.Errorf("ForGeneration(10) error = %v, want ErrUnknownGeneration", err)
+	}
+}
+
+func TestEffectiveness(t *testing.T) {
+	tests := []struct {
+		attacking string
+		defending []string
+		want      float64
+	}{
+		{"ground", []string{"flying", "steel"}, 0},
+		{"ice", []string{"dragon", "ground"}, 4},
+		{"fighting", []string{"normal", "flying"}, 1},
+		{"grass", []string{"bug", "steel"}, 0.25},
+		{"water", []string{"fire"}, 2},
+	}
+
+	for _, tt := range tests {
+		got, err := Latest().Effectiveness(tt.attacking, tt.defending...)
+		if err != nil {
+			t.Fatalf("Effectiveness(%q, %v) error = %v", tt.attacking, tt.defending, err)
+		}
+		if got != tt.want {
+			t.Errorf("Effectiveness(%q, %v) = %v, want %v", tt.attacking, tt.defending, got, tt.want)
+		}
+	}
+}
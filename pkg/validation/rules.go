@@ -0,0 +1,222 @@
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Struct checks the rules in the `validate` tags of v, a struct or a pointer
+// to one, and of the structs nested in it. Fields are named by their JSON
+// keys, e.g. "members[2].level":
+//
+//	Email    string `json:"email" validate:"required,email,max=255"`
+//	Password string `json:"password" validate:"required,min=6"`
+//
+// The rules are required, min=n and max=n (a length for strings and slices
+// and a value for numbers), email and oneof=a b c. A nil pointer is only
+// checked by required, so optional fields are validated when present.
+func Struct(v any) Errors {
+	var errs Errors
+	checkStruct(&errs, "", reflect.ValueOf(v))
+	return errs
+}
+
+func checkStruct(errs *Errors, prefix string, v reflect.Value) {
+	v = reflect.Indirect(v)
+	if v.Kind() != reflect.Struct {
+		return
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		name := jsonName(f)
+		if name == "-" {
+			continue
+		}
+
+		if f.Anonymous && name == "" {
+			checkStruct(errs, prefix, v.Field(i))
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+
+		path := name
+		if prefix != "" {
+			path = prefix + "." + name
+		}
+
+		value := v.Field(i)
+		if rules := f.Tag.Get("validate"); rules != "" && !checkField(errs, path, name, rules, value) {
+			continue
+		}
+
+		value = reflect.Indirect(value)
+		switch value.Kind() {
+		case reflect.Struct:
+			checkStruct(errs, path, value)
+		case reflect.Slice, reflect.Array:
+			for j := 0; j < value.Len(); j++ {
+				checkStruct(errs, fmt.Sprintf("%s[%d]", path, j), value.Index(j))
+			}
+		}
+	}
+}
+
+// jsonName returns the key of a field in its json tag, "" when the tag does
+// not name it and "-" when the field is not decoded.
+func jsonName(f reflect.StructField) string {
+	name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+	return name
+}
+
+// checkField applies the rules of a field, stopping at the first one that
+// fails, and reports whether all of them passed.
+func checkField(errs *Errors, path, name, rules string, v reflect.Value) bool {
+	for _, rule := range strings.Split(rules, ",") {
+		rule, param, _ := strings.Cut(strings.TrimSpace(rule), "=")
+
+		if rule == "required" {
+			if empty(v) {
+				errs.Add(path, "required", "%s is required", name)
+				return false
+			}
+			continue
+		}
+
+		if v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				return true
+			}
+			v = v.Elem()
+		}
+
+		switch rule {
+		case "min":
+			if !checkBound(errs, path, name, v, bound(rule, param), true) {
+				return false
+			}
+		case "max":
+			if !checkBound(errs, path, name, v, bound(rule, param), false) {
+				return false
+			}
+		case "email":
+			if v.Kind() == reflect.String && v.String() != "" && !validEmail(v.String()) {
+				errs.Add(path, "invalid_email", "%s must be a valid email address", name)
+				return false
+			}
+		case "oneof":
+			options := strings.Fields(param)
+			if v.Kind() == reflect.String && v.String() != "" && !contains(options, v.String()) {
+				errs.Add(path, "invalid_value", "%s must be one of %s", name, strings.Join(options, ", "))
+				return false
+			}
+		default:
+			panic(fmt.Sprintf("validation: unknown rule %q on %s", rule, path))
+		}
+	}
+
+	return true
+}
+
+// empty reports whether a required field is missing: nil, a blank string or
+// an empty collection. Zero numbers and false are values.
+func empty(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		return v.IsNil()
+	case reflect.String:
+		return strings.TrimSpace(v.String()) == ""
+	case reflect.Slice, reflect.Map, reflect.Array:
+		return v.Len() == 0
+	}
+
+	return false
+}
+
+func bound(rule, param string) int {
+	n, err := strconv.Atoi(param)
+	if err != nil {
+		panic(fmt.Sprintf("validation: %s needs a number, got %q", rule, param))
+	}
+
+	return n
+}
+
+func checkBound(errs *Errors, path, name string, v reflect.Value, n int, lower bool) bool {
+	switch v.Kind() {
+	case reflect.String:
+		length := utf8.RuneCountInString(v.String())
+		switch {
+		case lower && length < n:
+			errs.Add(path, "too_short", "%s must be at least %d characters long", name, n)
+		case !lower && length > n:
+			errs.Add(path, "too_long", "%s must be at most %d characters long", name, n)
+		default:
+			return true
+		}
+	case reflect.Slice, reflect.Array, reflect.Map:
+		switch {
+		case lower && v.Len() < n:
+			errs.Add(path, "too_few", "%s must have at least %d items", name, n)
+		case !lower && v.Len() > n:
+			errs.Add(path, "too_many", "%s must have at most %d items", name, n)
+		default:
+			return true
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return checkRange(errs, path, name, float64(v.Int()), n, lower)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return checkRange(errs, path, name, float64(v.Uint()), n, lower)
+	case reflect.Float32, reflect.Float64:
+		return checkRange(errs, path, name, v.Float(), n, lower)
+	default:
+		return true
+	}
+
+	return false
+}
+
+func checkRange(errs *Errors, path, name string, value float64, n int, lower bool) bool {
+	switch {
+	case lower && value < float64(n):
+		errs.Add(path, "out_of_range", "%s must be at least %d", name, n)
+	case !lower && value > float64(n):
+		errs.Add(path, "out_of_range", "%s must be at most %d", name, n)
+	default:
+		return true
+	}
+
+	return false
+}
+
+// validEmail is a sanity check rather than RFC 5322: something before the @
+// and a dotted domain after it.
+func validEmail(email string) bool {
+	at := strings.LastIndex(email, "@")
+	if at <= 0 || at == len(email)-1 || strings.ContainsAny(email, " \t\r\n") {
+		return false
+	}
+
+	domain := email[at+1:]
+	return strings.Contains(domain, ".") && !strings.HasPrefix(domain, ".") && !strings.HasSuffix(domain, ".")
+}
+
+func contains(options []string, value string) bool {
+	for _, option := range options {
+		if option == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,78 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/message"
+)
+
+// FieldError describes why a single field of a request was rejected. Field is
+// a path such as "members[2].evs.attack" and Code is a stable identifier
+// clients can switch on, while Message is meant for humans. Line points into
+// text input such as a Showdown paste, when the field was read from one.
+type FieldError struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	Line    int    `json:"line,omitempty"`
+
+	// format and args are kept to render Message again in the language of
+	// the request.
+	format string
+	args   []any
+}
+
+// Errors collects every field error of a request so they are reported at once
+// instead of one per round trip.
+type Errors []FieldError
+
+func (e Errors) Error() string {
+	parts := make([]string, 0, len(e))
+	for _, fe := range e {
+		parts = append(parts, fe.Field+": "+fe.Message)
+	}
+
+	return "validation failed: " + strings.Join(parts, "; ")
+}
+
+func (e *Errors) Add(field, code, format string, args ...any) {
+	e.AddLine(field, 0, code, format, args...)
+}
+
+// AddLine adds an error for a field read from the given line; a zero line is
+// left out of the response.
+func (e *Errors) AddLine(field string, line int, code, format string, args ...any) {
+	*e = append(*e, FieldError{
+		Field:   field,
+		Code:    code,
+		Message: fmt.Sprintf(format, args...),
+		Line:    line,
+		format:  format,
+		args:    args,
+	})
+}
+
+// Localize returns a copy of the errors with every message rendered by p,
+// which translates them to its language.
+func (e Errors) Localize(p *message.Printer) Errors {
+	localized := make(Errors, len(e))
+	for i, fe := range e {
+		localized[i] = fe
+		if fe.format != "" {
+			localized[i].Message = p.Sprintf(fe.format, fe.args...)
+		}
+	}
+
+	return localized
+}
+
+// Err returns nil when no error was added, so a collected Errors can be
+// returned directly.
+func (e Errors) Err() error {
+	if len(e) == 0 {
+		return nil
+	}
+
+	return e
+}